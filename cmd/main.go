@@ -1,13 +1,25 @@
 package main
 
 import (
+	"fmt"
 	"log"
+	"os"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/veliulugut/snapclean/internal/cli"
 	"github.com/veliulugut/snapclean/internal/tui"
 )
 
 func main() {
+	// Any arguments run the command line interface instead of the TUI
+	if len(os.Args) > 1 {
+		if err := cli.Run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+			fmt.Fprintln(os.Stderr, "error:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	app := tui.InitialModel()
 	program := tea.NewProgram(app, tea.WithAltScreen())
 
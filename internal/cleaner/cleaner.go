@@ -1,6 +1,7 @@
 package cleaner
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/veliulugut/snapclean/internal/models"
@@ -12,7 +13,7 @@ func RemoveEmptyRows(dt *models.DataTable) *models.DataTable {
 	var filteredRows [][]string
 
 	for _, row := range result.Rows {
-		if !isRowEmpty(row) {
+		if !isRowEmpty(dt, row) {
 			filteredRows = append(filteredRows, row)
 		}
 	}
@@ -39,7 +40,7 @@ func RemoveEmptyColumns(dt *models.DataTable) *models.DataTable {
 		// Check if column has any non-empty cell
 		hasContent := false
 		for _, cell := range column {
-			if strings.TrimSpace(cell) != "" {
+			if !dt.IsMissing(colIdx, cell) {
 				hasContent = true
 				break
 			}
@@ -86,6 +87,7 @@ func NormalizeHeaders(dt *models.DataTable) *models.DataTable {
 		// Remove leading/trailing underscores
 		normalized = strings.Trim(normalized, "_")
 
+		result.Nulls.RenameColumn(header, normalized)
 		result.Headers[i] = normalized
 	}
 
@@ -119,8 +121,9 @@ func TrimWhitespace(dt *models.DataTable) *models.DataTable {
 	result := dt.Clone()
 
 	// Trim headers
-	for i := range result.Headers {
-		result.Headers[i] = strings.TrimSpace(result.Headers[i])
+	for i, header := range result.Headers {
+		result.Headers[i] = strings.TrimSpace(header)
+		result.Nulls.RenameColumn(header, result.Headers[i])
 	}
 
 	// Trim rows
@@ -133,6 +136,38 @@ func TrimWhitespace(dt *models.DataTable) *models.DataTable {
 	return result
 }
 
+// StandardizeNulls replaces every missing cell (blank or null marker) with one representation
+func StandardizeNulls(dt *models.DataTable, representation string) *models.DataTable {
+	result := dt.Clone()
+
+	for i := range result.Rows {
+		for j := range result.Rows[i] {
+			if result.IsMissing(j, result.Rows[i][j]) {
+				result.Rows[i][j] = representation
+			}
+		}
+	}
+
+	return result
+}
+
+// SetNullMarkers replaces the extra null markers of a column
+func SetNullMarkers(dt *models.DataTable, column string, markers []string) (*models.DataTable, error) {
+	if dt == nil {
+		return nil, fmt.Errorf("no data loaded")
+	}
+	if dt.ColumnIndex(column) < 0 {
+		return nil, fmt.Errorf("column %q not found", column)
+	}
+
+	result := dt.Clone()
+	if result.Nulls == nil {
+		result.Nulls = &models.NullMarkers{}
+	}
+	result.Nulls.SetColumn(column, append([]string(nil), markers...))
+	return result, nil
+}
+
 // ApplyCleaningOptions applies multiple cleaning operations in order
 func ApplyCleaningOptions(dt *models.DataTable, opts models.CleanOptions) *models.DataTable {
 	if dt == nil || dt.IsEmpty() {
@@ -141,11 +176,23 @@ func ApplyCleaningOptions(dt *models.DataTable, opts models.CleanOptions) *model
 
 	result := dt
 
-	// Order matters: trim first, then normalize, then remove empty, then deduplicate
+	// Order matters: trim first, then clean up text, then standardize nulls, then normalize, then remove empty, then deduplicate
 	if opts.TrimWhitespace {
 		result = TrimWhitespace(result)
 	}
 
+	if opts.CollapseSpaces || opts.RemoveInvisible || opts.NormalizeUnicode {
+		result, _, _ = StandardizeText(result, TextOptions{
+			CollapseSpaces:   opts.CollapseSpaces,
+			RemoveInvisible:  opts.RemoveInvisible,
+			NormalizeUnicode: opts.NormalizeUnicode,
+		})
+	}
+
+	if opts.StandardizeNulls {
+		result = StandardizeNulls(result, opts.NullValue)
+	}
+
 	if opts.NormalizeHeaders {
 		result = NormalizeHeaders(result)
 	}
@@ -167,10 +214,10 @@ func ApplyCleaningOptions(dt *models.DataTable, opts models.CleanOptions) *model
 
 // Helper functions
 
-// isRowEmpty checks if all cells in a row are empty or whitespace
-func isRowEmpty(row []string) bool {
-	for _, cell := range row {
-		if strings.TrimSpace(cell) != "" {
+// isRowEmpty checks if all cells in a row are missing (blank or null markers)
+func isRowEmpty(dt *models.DataTable, row []string) bool {
+	for i, cell := range row {
+		if !dt.IsMissing(i, cell) {
 			return false
 		}
 	}
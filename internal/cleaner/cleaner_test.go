@@ -123,4 +123,74 @@ func TestApplyCleaningOptionsNil(t *testing.T) {
     if got := ApplyCleaningOptions(dt, opts); got != nil {
         t.Errorf("expected nil result when input is nil")
     }
-}
\ No newline at end of file
+}
+
+func TestNullMarkersHonoredByCleaners(t *testing.T) {
+    dt := models.NewDataTable([]string{"Name", "Notes"})
+    dt.Nulls = models.DefaultNullMarkers()
+    dt.AddRow([]string{"John", "N/A"})
+    dt.AddRow([]string{"NULL", "-"})
+    dt.AddRow([]string{"Jane", "none"})
+
+    got := RemoveEmptyColumns(RemoveEmptyRows(dt))
+
+    if got.RowCount() != 2 {
+        t.Errorf("expected 2 rows, got %d", got.RowCount())
+    }
+
+    if got.ColumnCount() != 1 || got.Headers[0] != "Name" {
+        t.Errorf("unexpected headers: %v", got.Headers)
+    }
+}
+
+func TestStandardizeNulls(t *testing.T) {
+    dt := models.NewDataTable([]string{"Code", "Date"})
+    dt.Nulls = &models.NullMarkers{Global: []string{"?"}}
+    dt.Nulls.SetColumn("Date", []string{"0000-00-00"})
+    dt.AddRow([]string{"?", "0000-00-00"})
+    dt.AddRow([]string{"A1", " "})
+
+    got := StandardizeNulls(dt, "NA")
+
+    want := [][]string{{"NA", "NA"}, {"A1", "NA"}}
+    for i := range want {
+        for j := range want[i] {
+            if got.Rows[i][j] != want[i][j] {
+                t.Errorf("cell %d,%d: want %s, got %s", i, j, want[i][j], got.Rows[i][j])
+            }
+        }
+    }
+}
+
+func TestSetNullMarkers(t *testing.T) {
+    dt := models.NewDataTable([]string{"Code", "Region"})
+    dt.AddRow([]string{"1", "NA"})
+
+    got, err := SetNullMarkers(dt, "Region", []string{"NA"})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    if !got.IsMissing(1, "NA") {
+        t.Errorf("expected NA to be missing in Region")
+    }
+    if dt.Nulls != nil {
+        t.Errorf("expected input table to be left unchanged")
+    }
+
+    if _, err := SetNullMarkers(dt, "Missing", nil); err == nil {
+        t.Errorf("expected error for an unknown column")
+    }
+}
+
+func TestNormalizeHeadersKeepsColumnNullMarkers(t *testing.T) {
+    dt := models.NewDataTable([]string{"Birth Date"})
+    dt.Nulls = &models.NullMarkers{}
+    dt.Nulls.SetColumn("Birth Date", []string{"0000-00-00"})
+
+    got := NormalizeHeaders(dt)
+
+    if !got.IsMissing(0, "0000-00-00") {
+        t.Errorf("expected per-column marker to follow renamed header %q", got.Headers[0])
+    }
+}
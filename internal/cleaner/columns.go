@@ -0,0 +1,458 @@
+package cleaner
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// SplitMode defines how a column is split into several columns
+type SplitMode string
+
+const (
+	SplitByDelimiter SplitMode = "delimiter" // Split on a separator string
+	SplitByRegex     SplitMode = "regex"     // One new column per capture group
+	SplitByPositions SplitMode = "positions" // Cut at fixed character positions
+)
+
+// SplitOptions configures SplitColumn
+type SplitOptions struct {
+	Column       string    `json:"column"`                  // Header of the column to split
+	Mode         SplitMode `json:"mode"`                    // Split mode
+	Delimiter    string    `json:"delimiter,omitempty"`     // Separator for SplitByDelimiter
+	Parts        int       `json:"parts,omitempty"`         // Max parts for SplitByDelimiter (0 = as many as found); the last part keeps the rest
+	Pattern      string    `json:"pattern,omitempty"`       // Regular expression with capture groups for SplitByRegex
+	Positions    []int     `json:"positions,omitempty"`     // Cut positions (in characters) for SplitByPositions
+	NewColumns   []string  `json:"new_columns,omitempty"`   // Names of the new columns (defaults to "<column>_1", "<column>_2", ...)
+	KeepOriginal bool      `json:"keep_original,omitempty"` // Keep the source column
+}
+
+// MergeOptions configures MergeColumns
+type MergeOptions struct {
+	Columns      []string `json:"columns"`                 // Headers of the columns to merge, in order
+	Separator    string   `json:"separator"`               // Text placed between values
+	NewColumn    string   `json:"new_column"`              // Header of the merged column
+	SkipMissing  bool     `json:"skip_missing,omitempty"`  // Leave out missing values instead of joining empty strings
+	KeepOriginal bool     `json:"keep_original,omitempty"` // Keep the source columns
+}
+
+// SplitColumn splits one column into several new columns placed right after it
+func SplitColumn(dt *models.DataTable, opts SplitOptions) (*models.DataTable, error) {
+	if dt == nil {
+		return nil, fmt.Errorf("no data to split")
+	}
+
+	colIdx := dt.ColumnIndex(opts.Column)
+	if colIdx == -1 {
+		return nil, fmt.Errorf("column %q not found", opts.Column)
+	}
+
+	splitter, names, err := newSplitter(dt, colIdx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Figure out the number of parts from the names or the data
+	parts := len(names)
+	if parts == 0 {
+		for _, row := range dt.Rows {
+			if colIdx < len(row) {
+				parts = max(parts, len(splitter(row[colIdx])))
+			}
+		}
+	}
+	if parts == 0 {
+		return nil, fmt.Errorf("column %q produced no parts to split into", opts.Column)
+	}
+
+	names = splitColumnNames(opts, names, parts)
+	for _, name := range names {
+		if dt.ColumnIndex(name) != -1 && !(name == opts.Column && !opts.KeepOriginal) {
+			return nil, fmt.Errorf("column %q already exists", name)
+		}
+	}
+
+	// New columns go right after the source column
+	insertAt := colIdx + 1
+	result := dt.Clone()
+	result.Headers = spliceColumns(dt.Headers, colIdx, insertAt, names, opts.KeepOriginal)
+
+	for i, row := range dt.Rows {
+		values := make([]string, parts)
+		if colIdx < len(row) && !dt.IsMissing(colIdx, row[colIdx]) {
+			copy(values, splitter(row[colIdx]))
+		}
+		result.Rows[i] = spliceColumns(row, colIdx, insertAt, values, opts.KeepOriginal)
+	}
+
+	return result, nil
+}
+
+// MergeColumns joins several columns into one placed where the first source column was
+func MergeColumns(dt *models.DataTable, opts MergeOptions) (*models.DataTable, error) {
+	if dt == nil {
+		return nil, fmt.Errorf("no data to merge")
+	}
+	if len(opts.Columns) < 2 {
+		return nil, fmt.Errorf("at least two columns are needed to merge")
+	}
+	if opts.NewColumn == "" {
+		return nil, fmt.Errorf("merged column needs a name")
+	}
+
+	indices, err := resolveColumns(dt, opts.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make(map[int]bool, len(indices))
+	for _, idx := range indices {
+		sources[idx] = true
+	}
+	if existing := dt.ColumnIndex(opts.NewColumn); existing != -1 && (opts.KeepOriginal || !sources[existing]) {
+		return nil, fmt.Errorf("column %q already exists", opts.NewColumn)
+	}
+
+	first := indices[0]
+	for _, idx := range indices {
+		first = min(first, idx)
+	}
+
+	merge := func(row []string) string {
+		var values []string
+		for _, idx := range indices {
+			value := ""
+			if idx < len(row) {
+				value = row[idx]
+			}
+			if opts.SkipMissing && dt.IsMissing(idx, value) {
+				continue
+			}
+			values = append(values, value)
+		}
+		return strings.Join(values, opts.Separator)
+	}
+
+	// Rebuild each row: merged value at the first source position, sources dropped unless kept
+	rebuild := func(cells []string, merged string) []string {
+		out := make([]string, 0, len(cells)+1)
+		for i, cell := range cells {
+			if i == first {
+				if opts.KeepOriginal {
+					out = append(out, cell)
+				}
+				out = append(out, merged)
+				continue
+			}
+			if sources[i] && !opts.KeepOriginal {
+				continue
+			}
+			out = append(out, cell)
+		}
+		return out
+	}
+
+	result := dt.Clone()
+	result.Headers = rebuild(dt.Headers, opts.NewColumn)
+	for i, row := range dt.Rows {
+		result.Rows[i] = rebuild(row, merge(row))
+	}
+
+	return result, nil
+}
+
+// newSplitter returns a function splitting a value into parts, plus any column
+// names implied by the options (e.g. named regex groups)
+func newSplitter(dt *models.DataTable, colIdx int, opts SplitOptions) (func(string) []string, []string, error) {
+	names := opts.NewColumns
+
+	switch opts.Mode {
+	case SplitByDelimiter:
+		if opts.Delimiter == "" {
+			return nil, nil, fmt.Errorf("delimiter is empty")
+		}
+		n := opts.Parts
+		if n <= 0 && len(names) > 0 {
+			n = len(names)
+		} else if n <= 0 {
+			n = -1
+		}
+		return func(value string) []string {
+			parts := strings.SplitN(value, opts.Delimiter, n)
+			for i := range parts {
+				parts[i] = strings.TrimSpace(parts[i])
+			}
+			return parts
+		}, limitNames(names, opts.Parts), nil
+
+	case SplitByRegex:
+		re, err := regexp.Compile(opts.Pattern)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid regular expression: %w", err)
+		}
+		if re.NumSubexp() == 0 {
+			return nil, nil, fmt.Errorf("regular expression has no capture groups")
+		}
+		if len(names) == 0 {
+			for i, name := range re.SubexpNames()[1:] {
+				if name == "" {
+					name = fmt.Sprintf("%s_%d", dt.Headers[colIdx], i+1)
+				}
+				names = append(names, name)
+			}
+		}
+		return func(value string) []string {
+			m := re.FindStringSubmatch(value)
+			if m == nil {
+				return nil
+			}
+			return m[1:]
+		}, names, nil
+
+	case SplitByPositions:
+		if len(opts.Positions) == 0 {
+			return nil, nil, fmt.Errorf("no split positions given")
+		}
+		for i, pos := range opts.Positions {
+			if pos <= 0 || (i > 0 && pos <= opts.Positions[i-1]) {
+				return nil, nil, fmt.Errorf("split positions must be positive and increasing")
+			}
+		}
+		return func(value string) []string {
+			runes := []rune(value)
+			parts := make([]string, 0, len(opts.Positions)+1)
+			start := 0
+			for i := 0; i <= len(opts.Positions); i++ {
+				end := len(runes)
+				if i < len(opts.Positions) {
+					end = min(opts.Positions[i], len(runes))
+				}
+				start = min(start, end)
+				parts = append(parts, strings.TrimSpace(string(runes[start:end])))
+				start = end
+			}
+			return parts
+		}, limitNames(names, len(opts.Positions)+1), nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown split mode %q", opts.Mode)
+	}
+}
+
+// DeleteColumns removes the given columns
+func DeleteColumns(dt *models.DataTable, columns []string) (*models.DataTable, error) {
+	remove, err := columnSet(dt, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var keep []int
+	for colIdx := range dt.Headers {
+		if !remove[colIdx] {
+			keep = append(keep, colIdx)
+		}
+	}
+	return selectColumns(dt, keep, nil), nil
+}
+
+// KeepColumns removes every column except the given ones, keeping the table order
+func KeepColumns(dt *models.DataTable, columns []string) (*models.DataTable, error) {
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("no columns to keep")
+	}
+	keepSet, err := columnSet(dt, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var keep []int
+	for colIdx := range dt.Headers {
+		if keepSet[colIdx] {
+			keep = append(keep, colIdx)
+		}
+	}
+	return selectColumns(dt, keep, nil), nil
+}
+
+// RenameColumn changes the header of a column
+func RenameColumn(dt *models.DataTable, column, name string) (*models.DataTable, error) {
+	colIdx, err := columnIndex(dt, column)
+	if err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("new column name is empty")
+	}
+	if name != column && dt.ColumnIndex(name) != -1 {
+		return nil, fmt.Errorf("column %q already exists", name)
+	}
+
+	result := dt.Clone()
+	result.Headers[colIdx] = name
+	result.Nulls.RenameColumn(column, name)
+	return result, nil
+}
+
+// DuplicateColumn inserts a copy of a column right after it
+// An empty name defaults to "<column>_copy"
+func DuplicateColumn(dt *models.DataTable, column, name string) (*models.DataTable, error) {
+	colIdx, err := columnIndex(dt, column)
+	if err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = column + "_copy"
+	}
+	if dt.ColumnIndex(name) != -1 {
+		return nil, fmt.Errorf("column %q already exists", name)
+	}
+
+	order := make([]int, 0, len(dt.Headers)+1)
+	for i := range dt.Headers {
+		order = append(order, i)
+		if i == colIdx {
+			order = append(order, i)
+		}
+	}
+	result := selectColumns(dt, order, map[int]string{colIdx + 1: name})
+	if result.Nulls != nil {
+		if markers, ok := result.Nulls.PerColumn[column]; ok {
+			result.Nulls.SetColumn(name, append([]string(nil), markers...))
+		}
+	}
+	return result, nil
+}
+
+// MoveColumn moves a column to a 0-based position, shifting the columns in between
+func MoveColumn(dt *models.DataTable, column string, position int) (*models.DataTable, error) {
+	colIdx, err := columnIndex(dt, column)
+	if err != nil {
+		return nil, err
+	}
+	if position < 0 || position >= len(dt.Headers) {
+		return nil, fmt.Errorf("position %d out of range 1-%d", position+1, len(dt.Headers))
+	}
+
+	order := make([]int, 0, len(dt.Headers))
+	for i := range dt.Headers {
+		if i != colIdx {
+			order = append(order, i)
+		}
+	}
+	order = append(order[:position], append([]int{colIdx}, order[position:]...)...)
+	return selectColumns(dt, order, nil), nil
+}
+
+// SwapColumns exchanges the positions of two columns
+func SwapColumns(dt *models.DataTable, column, other string) (*models.DataTable, error) {
+	a, err := columnIndex(dt, column)
+	if err != nil {
+		return nil, err
+	}
+	b, err := columnIndex(dt, other)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]int, len(dt.Headers))
+	for i := range order {
+		order[i] = i
+	}
+	order[a], order[b] = order[b], order[a]
+	return selectColumns(dt, order, nil), nil
+}
+
+// Helper functions
+
+// columnIndex resolves a header, failing when it does not exist
+func columnIndex(dt *models.DataTable, column string) (int, error) {
+	if dt == nil {
+		return -1, fmt.Errorf("no data loaded")
+	}
+	colIdx := dt.ColumnIndex(column)
+	if colIdx == -1 {
+		return -1, fmt.Errorf("column %q not found", column)
+	}
+	return colIdx, nil
+}
+
+// columnSet resolves headers to a set of column indices
+func columnSet(dt *models.DataTable, columns []string) (map[int]bool, error) {
+	set := make(map[int]bool, len(columns))
+	for _, column := range columns {
+		colIdx, err := columnIndex(dt, column)
+		if err != nil {
+			return nil, err
+		}
+		set[colIdx] = true
+	}
+	return set, nil
+}
+
+// selectColumns builds a table from the given source columns, in order
+// names overrides the header at an output position
+func selectColumns(dt *models.DataTable, order []int, names map[int]string) *models.DataTable {
+	result := dt.Clone()
+	result.Headers = make([]string, len(order))
+	for i, colIdx := range order {
+		result.Headers[i] = dt.Headers[colIdx]
+		if name, ok := names[i]; ok {
+			result.Headers[i] = name
+		}
+	}
+
+	for r, row := range dt.Rows {
+		out := make([]string, len(order))
+		for i, colIdx := range order {
+			if colIdx < len(row) {
+				out[i] = row[colIdx]
+			}
+		}
+		result.Rows[r] = out
+	}
+
+	return result
+}
+
+// limitNames returns names only when they fix the number of parts
+func limitNames(names []string, parts int) []string {
+	if len(names) == 0 && parts > 0 {
+		return make([]string, parts)
+	}
+	return names
+}
+
+// splitColumnNames fills in default names for unnamed parts
+func splitColumnNames(opts SplitOptions, names []string, parts int) []string {
+	out := make([]string, parts)
+	for i := range out {
+		if i < len(names) && names[i] != "" {
+			out[i] = names[i]
+		} else {
+			out[i] = fmt.Sprintf("%s_%d", opts.Column, i+1)
+		}
+	}
+	return out
+}
+
+// spliceColumns inserts values at insertAt, dropping the source column unless kept
+func spliceColumns(cells []string, colIdx, insertAt int, values []string, keepOriginal bool) []string {
+	out := make([]string, 0, len(cells)+len(values))
+	for i := 0; i < len(cells); i++ {
+		if i == insertAt {
+			out = append(out, values...)
+		}
+		if i == colIdx && !keepOriginal {
+			continue
+		}
+		out = append(out, cells[i])
+	}
+	if insertAt >= len(cells) {
+		out = append(out, values...)
+	}
+	return out
+}
@@ -0,0 +1,199 @@
+package cleaner
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func TestSplitColumnByDelimiter(t *testing.T) {
+	dt := models.NewDataTable([]string{"ID", "Name", "City"})
+	dt.AddRow([]string{"1", "John Smith", "NYC"})
+	dt.AddRow([]string{"2", "Mary Ann Lee", "LA"})
+	dt.AddRow([]string{"3", "", "SF"})
+
+	got, err := SplitColumn(dt, SplitOptions{
+		Column:     "Name",
+		Mode:       SplitByDelimiter,
+		Delimiter:  " ",
+		NewColumns: []string{"First", "Last"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantHeaders := []string{"ID", "First", "Last", "City"}
+	if !reflect.DeepEqual(got.Headers, wantHeaders) {
+		t.Errorf("want headers %v, got %v", wantHeaders, got.Headers)
+	}
+
+	wantRows := [][]string{
+		{"1", "John", "Smith", "NYC"},
+		{"2", "Mary", "Ann Lee", "LA"},
+		{"3", "", "", "SF"},
+	}
+	if !reflect.DeepEqual(got.Rows, wantRows) {
+		t.Errorf("want rows %v, got %v", wantRows, got.Rows)
+	}
+}
+
+func TestSplitColumnDefaultNamesAndKeepOriginal(t *testing.T) {
+	dt := models.NewDataTable([]string{"Tags"})
+	dt.AddRow([]string{"a;b"})
+	dt.AddRow([]string{"a;b;c"})
+
+	got, err := SplitColumn(dt, SplitOptions{Column: "Tags", Mode: SplitByDelimiter, Delimiter: ";", KeepOriginal: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantHeaders := []string{"Tags", "Tags_1", "Tags_2", "Tags_3"}
+	if !reflect.DeepEqual(got.Headers, wantHeaders) {
+		t.Errorf("want headers %v, got %v", wantHeaders, got.Headers)
+	}
+
+	if !reflect.DeepEqual(got.Rows[0], []string{"a;b", "a", "b", ""}) {
+		t.Errorf("Unexpected first row: %v", got.Rows[0])
+	}
+}
+
+func TestSplitColumnByRegexAndPositions(t *testing.T) {
+	dt := models.NewDataTable([]string{"Address"})
+	dt.AddRow([]string{"34000 Istanbul"})
+	dt.AddRow([]string{"unknown"})
+
+	got, err := SplitColumn(dt, SplitOptions{Column: "Address", Mode: SplitByRegex, Pattern: `^(?P<zip>\d{5}) (?P<city>.+)$`})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got.Headers, []string{"zip", "city"}) {
+		t.Errorf("Expected named groups as headers, got %v", got.Headers)
+	}
+	if !reflect.DeepEqual(got.Rows, [][]string{{"34000", "Istanbul"}, {"", ""}}) {
+		t.Errorf("Unexpected rows: %v", got.Rows)
+	}
+
+	got, err = SplitColumn(dt, SplitOptions{Column: "Address", Mode: SplitByPositions, Positions: []int{5}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got.Rows[0], []string{"34000", "Istanbul"}) {
+		t.Errorf("Unexpected fixed-position split: %v", got.Rows[0])
+	}
+}
+
+func TestSplitColumnErrors(t *testing.T) {
+	dt := models.NewDataTable([]string{"A", "A_1"})
+	dt.AddRow([]string{"x-y", ""})
+
+	if _, err := SplitColumn(dt, SplitOptions{Column: "A", Mode: SplitByDelimiter, Delimiter: "-"}); err == nil {
+		t.Error("Expected error for conflicting column name")
+	}
+	if _, err := SplitColumn(dt, SplitOptions{Column: "A", Mode: SplitByRegex, Pattern: `x`}); err == nil {
+		t.Error("Expected error for regex without groups")
+	}
+	if _, err := SplitColumn(dt, SplitOptions{Column: "A", Mode: SplitByPositions, Positions: []int{3, 2}}); err == nil {
+		t.Error("Expected error for decreasing positions")
+	}
+}
+
+func TestMergeColumns(t *testing.T) {
+	dt := models.NewDataTable([]string{"ID", "First", "Age", "Last"})
+	dt.AddRow([]string{"1", "John", "30", "Smith"})
+	dt.AddRow([]string{"2", "Cher", "70", ""})
+
+	got, err := MergeColumns(dt, MergeOptions{
+		Columns:     []string{"First", "Last"},
+		Separator:   " ",
+		NewColumn:   "FullName",
+		SkipMissing: true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.Headers, []string{"ID", "FullName", "Age"}) {
+		t.Errorf("Unexpected headers: %v", got.Headers)
+	}
+	if !reflect.DeepEqual(got.Rows, [][]string{{"1", "John Smith", "30"}, {"2", "Cher", "70"}}) {
+		t.Errorf("Unexpected rows: %v", got.Rows)
+	}
+
+	if _, err := MergeColumns(dt, MergeOptions{Columns: []string{"First"}, NewColumn: "X"}); err == nil {
+		t.Error("Expected error when merging a single column")
+	}
+	if _, err := MergeColumns(dt, MergeOptions{Columns: []string{"First", "Last"}, NewColumn: "Age"}); err == nil {
+		t.Error("Expected error for conflicting column name")
+	}
+}
+
+func TestColumnManagement(t *testing.T) {
+	dt := models.NewDataTable([]string{"a", "b", "c"})
+	dt.AddRow([]string{"1", "2", "3"})
+	dt.Nulls = &models.NullMarkers{PerColumn: map[string][]string{"b": {"-"}}}
+
+	tests := []struct {
+		name    string
+		run     func() (*models.DataTable, error)
+		headers []string
+		row     []string
+	}{
+		{"delete", func() (*models.DataTable, error) { return DeleteColumns(dt, []string{"a", "c"}) },
+			[]string{"b"}, []string{"2"}},
+		{"keep", func() (*models.DataTable, error) { return KeepColumns(dt, []string{"c", "a"}) },
+			[]string{"a", "c"}, []string{"1", "3"}},
+		{"rename", func() (*models.DataTable, error) { return RenameColumn(dt, "b", "beta") },
+			[]string{"a", "beta", "c"}, []string{"1", "2", "3"}},
+		{"duplicate", func() (*models.DataTable, error) { return DuplicateColumn(dt, "a", "") },
+			[]string{"a", "a_copy", "b", "c"}, []string{"1", "1", "2", "3"}},
+		{"move", func() (*models.DataTable, error) { return MoveColumn(dt, "c", 0) },
+			[]string{"c", "a", "b"}, []string{"3", "1", "2"}},
+		{"move right", func() (*models.DataTable, error) { return MoveColumn(dt, "a", 1) },
+			[]string{"b", "a", "c"}, []string{"2", "1", "3"}},
+		{"swap", func() (*models.DataTable, error) { return SwapColumns(dt, "a", "c") },
+			[]string{"c", "b", "a"}, []string{"3", "2", "1"}},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.run()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(got.Headers, tt.headers) || !reflect.DeepEqual(got.Rows[0], tt.row) {
+			t.Errorf("%s: expected %v %v, got %v %v", tt.name, tt.headers, tt.row, got.Headers, got.Rows[0])
+		}
+	}
+
+	if dt.Headers[0] != "a" || dt.Rows[0][0] != "1" {
+		t.Error("Expected the original table to be unchanged")
+	}
+
+	renamed, _ := RenameColumn(dt, "b", "beta")
+	if !renamed.IsMissing(1, "-") {
+		t.Error("Expected null markers to follow the renamed column")
+	}
+}
+
+func TestColumnManagementErrors(t *testing.T) {
+	dt := models.NewDataTable([]string{"a", "b"})
+
+	if _, err := DeleteColumns(dt, []string{"x"}); err == nil {
+		t.Error("Expected error deleting an unknown column")
+	}
+	if _, err := KeepColumns(dt, nil); err == nil {
+		t.Error("Expected error keeping no columns")
+	}
+	if _, err := RenameColumn(dt, "a", "b"); err == nil {
+		t.Error("Expected error renaming to an existing header")
+	}
+	if _, err := RenameColumn(dt, "a", " "); err == nil {
+		t.Error("Expected error renaming to an empty header")
+	}
+	if _, err := DuplicateColumn(dt, "a", "b"); err == nil {
+		t.Error("Expected error duplicating onto an existing header")
+	}
+	if _, err := MoveColumn(dt, "a", 2); err == nil {
+		t.Error("Expected error moving out of range")
+	}
+}
@@ -0,0 +1,71 @@
+package cleaner
+
+import (
+	"fmt"
+
+	"github.com/veliulugut/snapclean/internal/expr"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// ComputeOptions configures ComputeColumn
+type ComputeOptions struct {
+	Column     string `json:"column"`     // Header of the column to create or overwrite
+	Expression string `json:"expression"` // Expression evaluated for every row, e.g. price * qty
+}
+
+// RowError describes a failure on a single row
+type RowError struct {
+	Row     int
+	Message string
+}
+
+// ComputeResult contains the table with the computed column and any row errors
+type ComputeResult struct {
+	Table   *models.DataTable
+	Created bool       // True when a new column was added rather than overwritten
+	Errors  []RowError // Rows where the expression failed; their cells are left empty
+}
+
+// ComputeColumn evaluates an expression for each row and writes the result
+// into a new column, or overwrites the column if it already exists
+func ComputeColumn(dt *models.DataTable, opts ComputeOptions) (ComputeResult, error) {
+	if dt == nil {
+		return ComputeResult{}, fmt.Errorf("no data to compute")
+	}
+	if opts.Column == "" {
+		return ComputeResult{}, fmt.Errorf("computed column needs a name")
+	}
+
+	program, err := expr.Compile(opts.Expression, dt.Headers)
+	if err != nil {
+		return ComputeResult{}, fmt.Errorf("invalid expression: %w", err)
+	}
+	program = program.WithNulls(dt.IsMissing)
+
+	result := ComputeResult{Table: dt.Clone()}
+
+	colIdx := dt.ColumnIndex(opts.Column)
+	if colIdx == -1 {
+		result.Created = true
+		colIdx = dt.ColumnCount()
+		result.Table.Headers = append(result.Table.Headers, opts.Column)
+	}
+
+	for i, row := range dt.Rows {
+		value, err := program.Eval(row)
+
+		cell := value.String()
+		if err != nil {
+			cell = ""
+			result.Errors = append(result.Errors, RowError{Row: i, Message: err.Error()})
+		}
+
+		if result.Created {
+			result.Table.Rows[i] = append(result.Table.Rows[i], cell)
+		} else {
+			result.Table.Rows[i][colIdx] = cell
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package cleaner
+
+import (
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func TestComputeColumnCreate(t *testing.T) {
+	dt := models.NewDataTable([]string{"price", "qty"})
+	dt.AddRow([]string{"2.5", "4"})
+	dt.AddRow([]string{"abc", "1"})
+	dt.AddRow([]string{"", "3"})
+
+	got, err := ComputeColumn(dt, ComputeOptions{Column: "total", Expression: "price * qty"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !got.Created || got.Table.ColumnCount() != 3 {
+		t.Fatalf("Expected new column, got %v", got.Table.Headers)
+	}
+
+	want := []string{"10", "", ""}
+	for i, w := range want {
+		if got.Table.Rows[i][2] != w {
+			t.Errorf("row %d: want %q, got %q", i, w, got.Table.Rows[i][2])
+		}
+	}
+
+	if len(got.Errors) != 1 || got.Errors[0].Row != 1 {
+		t.Errorf("Expected one error on row 1, got %v", got.Errors)
+	}
+}
+
+func TestComputeColumnOverwrite(t *testing.T) {
+	dt := models.NewDataTable([]string{"city"})
+	dt.AddRow([]string{"ankara"})
+
+	got, err := ComputeColumn(dt, ComputeOptions{Column: "city", Expression: "upper(city)"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got.Created || got.Table.Rows[0][0] != "ANKARA" {
+		t.Errorf("Expected overwritten column, got %v", got.Table.Rows)
+	}
+
+	if dt.Rows[0][0] != "ankara" {
+		t.Error("Original table was modified")
+	}
+
+	if _, err := ComputeColumn(dt, ComputeOptions{Column: "x", Expression: "missing + 1"}); err == nil {
+		t.Error("Expected error for unknown column in expression")
+	}
+}
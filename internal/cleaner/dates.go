@@ -0,0 +1,353 @@
+package cleaner
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// Date formats reported by DetectDateFormats
+const (
+	DateFormatISO         = "YYYY-MM-DD"
+	DateFormatDayFirst    = "DD/MM/YYYY"
+	DateFormatMonthFirst  = "MM/DD/YYYY"
+	DateFormatMonthName   = "D Month YYYY"
+	DateFormatExcelSerial = "Excel serial"
+)
+
+// DateOptions configures date normalization
+type DateOptions struct {
+	OutputFormat string `json:"output_format,omitempty"` // Pattern such as "YYYY-MM-DD" or "DD.MM.YYYY" (default ISO 8601)
+	DayFirst     bool   `json:"day_first,omitempty"`     // Fallback for ambiguous dates like 03/04/2024 when the data gives no hint
+	ExcelSerial  bool   `json:"excel_serial,omitempty"`  // Read plain numbers in the serial range (e.g. 45385) as Excel dates
+}
+
+// DateResult contains the normalized table and a report of what was found
+type DateResult struct {
+	Table       *models.DataTable
+	Formats     []string         // Formats detected in the column
+	DayFirst    bool             // Resolution used for ambiguous numeric dates
+	Converted   int              // Number of cells converted
+	Unparseable []models.CellRef // Non-missing cells that could not be parsed (left unchanged)
+}
+
+var (
+	numericDatePattern = regexp.MustCompile(`^(\d{1,4})[./-](\d{1,2})[./-](\d{1,4})(?:[ T](\d{1,2}):(\d{2})(?::(\d{2}))?)?$`)
+	excelSerialPattern = regexp.MustCompile(`^\d{5}(\.\d+)?$`)
+	dateTokenSplitter  = regexp.MustCompile(`[\s,./-]+`)
+)
+
+// Plausible Excel serials (1954-10-03..2119-01-10); years, IDs and counts fall outside
+const (
+	excelSerialMin = 20000
+	excelSerialMax = 80000
+)
+
+// excelEpoch is day zero of Excel's 1900 date system (accounting for the 1900 leap year bug)
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// monthNames maps folded Turkish and English month names and abbreviations to months
+var monthNames = map[string]time.Month{
+	"ocak": time.January, "oca": time.January, "january": time.January, "jan": time.January,
+	"subat": time.February, "sub": time.February, "february": time.February, "feb": time.February,
+	"mart": time.March, "mar": time.March, "march": time.March,
+	"nisan": time.April, "nis": time.April, "april": time.April, "apr": time.April,
+	"mayis": time.May, "may": time.May,
+	"haziran": time.June, "haz": time.June, "june": time.June, "jun": time.June,
+	"temmuz": time.July, "tem": time.July, "july": time.July, "jul": time.July,
+	"agustos": time.August, "agu": time.August, "august": time.August, "aug": time.August,
+	"eylul": time.September, "eyl": time.September, "september": time.September, "sep": time.September, "sept": time.September,
+	"ekim": time.October, "eki": time.October, "october": time.October, "oct": time.October,
+	"kasim": time.November, "kas": time.November, "november": time.November, "nov": time.November,
+	"aralik": time.December, "ara": time.December, "december": time.December, "dec": time.December,
+}
+
+// NormalizeDates parses every value in a date column and rewrites it in the output format
+// Cells that cannot be parsed are left unchanged and reported in DateResult.Unparseable
+func NormalizeDates(dt *models.DataTable, column string, opts DateOptions) (DateResult, error) {
+	if dt == nil {
+		return DateResult{}, fmt.Errorf("no data to normalize")
+	}
+
+	colIdx := dt.ColumnIndex(column)
+	if colIdx == -1 {
+		return DateResult{}, fmt.Errorf("column %q not found", column)
+	}
+
+	format := opts.OutputFormat
+	if format == "" {
+		format = DateFormatISO
+	}
+	layout := DateLayout(format)
+
+	values, _ := dt.GetColumn(colIdx)
+	formats, dayFirst := detectDateFormats(dt, colIdx, values, opts.DayFirst, opts.ExcelSerial)
+
+	result := DateResult{
+		Table:    dt.Clone(),
+		Formats:  formats,
+		DayFirst: dayFirst,
+	}
+
+	for i, row := range result.Table.Rows {
+		if colIdx >= len(row) || result.Table.IsMissing(colIdx, row[colIdx]) {
+			continue
+		}
+
+		parsed, _, ok := parseDateValue(row[colIdx], dayFirst, opts.ExcelSerial)
+		if !ok {
+			result.Unparseable = append(result.Unparseable, models.CellRef{Row: i, Col: colIdx})
+			continue
+		}
+
+		row[colIdx] = parsed.Format(layout)
+		result.Converted++
+	}
+
+	return result, nil
+}
+
+// DetectDateFormats returns the date formats used in a column and the
+// day/month order inferred for ambiguous numeric dates
+func DetectDateFormats(dt *models.DataTable, column string, dayFirstFallback bool) ([]string, bool, error) {
+	colIdx := dt.ColumnIndex(column)
+	if colIdx == -1 {
+		return nil, false, fmt.Errorf("column %q not found", column)
+	}
+
+	values, _ := dt.GetColumn(colIdx)
+	formats, dayFirst := detectDateFormats(dt, colIdx, values, dayFirstFallback, false)
+	return formats, dayFirst, nil
+}
+
+// IsExcelSerialColumn reports whether a column holds plausible Excel serials and
+// every other non-missing value is a date, so the column can be normalized with
+// DateOptions.ExcelSerial (e.g. a column mixing "03/04/2024" and "45385")
+func IsExcelSerialColumn(dt *models.DataTable, column string) bool {
+	colIdx := dt.ColumnIndex(column)
+	if colIdx == -1 {
+		return false
+	}
+
+	found := false
+	for _, row := range dt.Rows {
+		if colIdx >= len(row) || dt.IsMissing(colIdx, row[colIdx]) {
+			continue
+		}
+		if _, ok := parseExcelSerial(row[colIdx]); ok {
+			found = true
+			continue
+		}
+		if _, _, ok := parseDate(row[colIdx], true); ok {
+			continue
+		}
+		if _, _, ok := parseDate(row[colIdx], false); !ok {
+			return false
+		}
+	}
+	return found
+}
+
+// DateLayout converts a pattern like "DD.MM.YYYY HH:mm" into a Go time layout
+func DateLayout(pattern string) string {
+	replacer := strings.NewReplacer(
+		"YYYY", "2006",
+		"YY", "06",
+		"MM", "01",
+		"DD", "02",
+		"HH", "15",
+		"mm", "04",
+		"ss", "05",
+	)
+	return replacer.Replace(pattern)
+}
+
+// detectDateFormats inspects all values of a column to find which formats are
+// used and whether ambiguous numeric dates are day-first
+func detectDateFormats(dt *models.DataTable, colIdx int, values []string, fallback, serials bool) ([]string, bool) {
+	dayFirstHints, monthFirstHints := 0, 0
+	for _, v := range values {
+		m := numericDatePattern.FindStringSubmatch(strings.TrimSpace(v))
+		if m == nil || len(m[1]) == 4 {
+			continue
+		}
+		first, _ := strconv.Atoi(m[1])
+		second, _ := strconv.Atoi(m[2])
+		if first > 12 && second <= 12 {
+			dayFirstHints++
+		} else if second > 12 && first <= 12 {
+			monthFirstHints++
+		}
+	}
+
+	dayFirst := fallback
+	if dayFirstHints > monthFirstHints {
+		dayFirst = true
+	} else if monthFirstHints > dayFirstHints {
+		dayFirst = false
+	}
+
+	seen := make(map[string]bool)
+	var formats []string
+	for _, v := range values {
+		if dt.IsMissing(colIdx, v) {
+			continue
+		}
+		if _, format, ok := parseDateValue(v, dayFirst, serials); ok && !seen[format] {
+			seen[format] = true
+			formats = append(formats, format)
+		}
+	}
+
+	return formats, dayFirst
+}
+
+// parseDate parses a single value, returning the time and the detected format
+// Plain numbers are never dates here, see parseDateValue for Excel serials
+func parseDate(value string, dayFirst bool) (time.Time, string, bool) {
+	value = strings.TrimSpace(value)
+
+	if m := numericDatePattern.FindStringSubmatch(value); m != nil {
+		return parseNumericDate(m, dayFirst)
+	}
+
+	return parseMonthNameDate(value)
+}
+
+// parseDateValue parses a value like parseDate, also reading Excel serials when asked to
+func parseDateValue(value string, dayFirst, serials bool) (time.Time, string, bool) {
+	if serials {
+		if t, ok := parseExcelSerial(value); ok {
+			return t, DateFormatExcelSerial, true
+		}
+	}
+	return parseDate(value, dayFirst)
+}
+
+// parseExcelSerial reads a number in the plausible serial range as an Excel date
+func parseExcelSerial(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if !excelSerialPattern.MatchString(value) {
+		return time.Time{}, false
+	}
+
+	serial, err := strconv.ParseFloat(value, 64)
+	if err != nil || serial < excelSerialMin || serial > excelSerialMax {
+		return time.Time{}, false
+	}
+	days := int(serial)
+	seconds := int((serial - float64(days)) * 86400)
+	return excelEpoch.AddDate(0, 0, days).Add(time.Duration(seconds) * time.Second), true
+}
+
+// parseNumericDate builds a date from a numericDatePattern match
+func parseNumericDate(m []string, dayFirst bool) (time.Time, string, bool) {
+	a, _ := strconv.Atoi(m[1])
+	b, _ := strconv.Atoi(m[2])
+	c, _ := strconv.Atoi(m[3])
+
+	var year, month, day int
+	var format string
+	switch {
+	case len(m[1]) == 4:
+		year, month, day = a, b, c
+		format = DateFormatISO
+	case len(m[3]) == 4 || len(m[3]) == 2:
+		year = expandYear(c, len(m[3]))
+		if dayFirst {
+			day, month = a, b
+			format = DateFormatDayFirst
+		} else {
+			month, day = a, b
+			format = DateFormatMonthFirst
+		}
+	default:
+		return time.Time{}, "", false
+	}
+
+	hour, _ := strconv.Atoi(m[4])
+	minute, _ := strconv.Atoi(m[5])
+	second, _ := strconv.Atoi(m[6])
+
+	t, ok := buildDate(year, month, day, hour, minute, second)
+	return t, format, ok
+}
+
+// parseMonthNameDate parses dates like "3 Nisan 2024", "April 3, 2024" or "03-Apr-2024"
+func parseMonthNameDate(value string) (time.Time, string, bool) {
+	tokens := dateTokenSplitter.Split(strings.TrimSpace(value), -1)
+	if len(tokens) != 3 {
+		return time.Time{}, "", false
+	}
+
+	month := time.Month(0)
+	var numbers []string
+	for _, tok := range tokens {
+		if m, ok := monthNames[foldTurkish(tok)]; ok && month == 0 {
+			month = m
+			continue
+		}
+		numbers = append(numbers, tok)
+	}
+	if month == 0 || len(numbers) != 2 {
+		return time.Time{}, "", false
+	}
+
+	// The four digit number is the year, the other one the day
+	dayStr, yearStr := numbers[0], numbers[1]
+	if len(dayStr) == 4 {
+		dayStr, yearStr = yearStr, dayStr
+	}
+	day, err := strconv.Atoi(strings.TrimSuffix(dayStr, "."))
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	year, err := strconv.Atoi(yearStr)
+	if err != nil || (len(yearStr) != 4 && len(yearStr) != 2) {
+		return time.Time{}, "", false
+	}
+
+	t, ok := buildDate(expandYear(year, len(yearStr)), int(month), day, 0, 0, 0)
+	return t, DateFormatMonthName, ok
+}
+
+// buildDate creates a date and rejects values that time.Date would roll over (e.g. 31/02)
+func buildDate(year, month, day, hour, minute, second int) (time.Time, bool) {
+	if month < 1 || month > 12 || day < 1 || hour > 23 || minute > 59 || second > 59 {
+		return time.Time{}, false
+	}
+	t := time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC)
+	if t.Day() != day || int(t.Month()) != month {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// expandYear turns two digit years into four digits (70-99 → 19xx, 00-69 → 20xx)
+func expandYear(year, digits int) int {
+	if digits != 2 {
+		return year
+	}
+	if year >= 70 {
+		return 1900 + year
+	}
+	return 2000 + year
+}
+
+// foldTurkish lowercases a word and strips Turkish diacritics so that
+// "ŞUBAT", "Şubat" and "subat" all compare equal
+func foldTurkish(s string) string {
+	replacer := strings.NewReplacer(
+		"İ", "i", "I", "i", "ı", "i",
+		"Ş", "s", "ş", "s",
+		"Ğ", "g", "ğ", "g",
+		"Ü", "u", "ü", "u",
+		"Ö", "o", "ö", "o",
+		"Ç", "c", "ç", "c",
+	)
+	return strings.ToLower(replacer.Replace(s))
+}
@@ -0,0 +1,147 @@
+package cleaner
+
+import (
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func TestNormalizeDatesMixedFormats(t *testing.T) {
+	dt := models.NewDataTable([]string{"Date"})
+	dt.AddRow([]string{"03/04/2024"})
+	dt.AddRow([]string{"2024-04-03"})
+	dt.AddRow([]string{"3 Nisan 2024"})
+	dt.AddRow([]string{"45385"})
+	dt.AddRow([]string{"25/12/2023"}) // day > 12 resolves ambiguity as day-first
+	dt.AddRow([]string{"ŞUBAT 1, 2024"})
+	dt.AddRow([]string{""})
+
+	got, err := NormalizeDates(dt, "Date", DateOptions{ExcelSerial: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !got.DayFirst {
+		t.Error("Expected day-first resolution from data")
+	}
+
+	want := []string{"2024-04-03", "2024-04-03", "2024-04-03", "2024-04-03", "2023-12-25", "2024-02-01", ""}
+	for i, w := range want {
+		if got.Table.Rows[i][0] != w {
+			t.Errorf("row %d: want %s, got %s", i, w, got.Table.Rows[i][0])
+		}
+	}
+
+	if got.Converted != 6 || len(got.Unparseable) != 0 {
+		t.Errorf("Expected 6 converted and no unparseable, got %d and %v", got.Converted, got.Unparseable)
+	}
+
+	if len(got.Formats) != 4 {
+		t.Errorf("Expected 4 detected formats, got %v", got.Formats)
+	}
+}
+
+func TestNormalizeDatesMonthFirstAndOutputFormat(t *testing.T) {
+	dt := models.NewDataTable([]string{"Date"})
+	dt.AddRow([]string{"04/03/2024"})
+	dt.AddRow([]string{"12/31/2023"})
+	dt.AddRow([]string{"April 3, 2024"})
+
+	got, err := NormalizeDates(dt, "Date", DateOptions{OutputFormat: "DD.MM.YYYY", DayFirst: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got.DayFirst {
+		t.Error("Expected month-first resolution from data")
+	}
+
+	want := []string{"03.04.2024", "31.12.2023", "03.04.2024"}
+	for i, w := range want {
+		if got.Table.Rows[i][0] != w {
+			t.Errorf("row %d: want %s, got %s", i, w, got.Table.Rows[i][0])
+		}
+	}
+}
+
+func TestNormalizeDatesReportsUnparseable(t *testing.T) {
+	dt := models.NewDataTable([]string{"Date"})
+	dt.AddRow([]string{"2024-02-31"})
+	dt.AddRow([]string{"soon"})
+	dt.AddRow([]string{"2024-01-15"})
+
+	got, err := NormalizeDates(dt, "Date", DateOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(got.Unparseable) != 2 || got.Unparseable[0].Row != 0 || got.Unparseable[1].Row != 1 {
+		t.Errorf("Expected rows 0 and 1 unparseable, got %v", got.Unparseable)
+	}
+
+	if got.Table.Rows[1][0] != "soon" {
+		t.Errorf("Expected unparseable cell unchanged, got %s", got.Table.Rows[1][0])
+	}
+
+	if _, err := NormalizeDates(dt, "Missing", DateOptions{}); err == nil {
+		t.Error("Expected error for unknown column")
+	}
+}
+
+func TestDateLayout(t *testing.T) {
+	if got := DateLayout("DD.MM.YYYY HH:mm:ss"); got != "02.01.2006 15:04:05" {
+		t.Errorf("Unexpected layout: %s", got)
+	}
+}
+
+func TestNormalizeDatesExcelSerials(t *testing.T) {
+	dt := models.NewDataTable([]string{"Value"})
+	dt.AddRow([]string{"2024"})
+	dt.AddRow([]string{"15"})
+	dt.AddRow([]string{"45385"})
+
+	// Plain numbers are not dates unless serials are asked for
+	got, err := NormalizeDates(dt, "Value", DateOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got.Converted != 0 || len(got.Unparseable) != 3 {
+		t.Errorf("Expected no conversions, got %d converted and %v", got.Converted, got.Unparseable)
+	}
+
+	// Years and small numbers stay out of the serial range
+	got, err = NormalizeDates(dt, "Value", DateOptions{ExcelSerial: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := []string{"2024", "15", "2024-04-03"}
+	for i, w := range want {
+		if got.Table.Rows[i][0] != w {
+			t.Errorf("row %d: want %s, got %s", i, w, got.Table.Rows[i][0])
+		}
+	}
+
+	if IsExcelSerialColumn(dt, "Value") {
+		t.Error("Expected a column with years not to be an Excel serial column")
+	}
+	serials := models.NewDataTable([]string{"Date"})
+	serials.AddRow([]string{"45385"})
+	serials.AddRow([]string{""})
+	if !IsExcelSerialColumn(serials, "Date") {
+		t.Error("Expected an Excel serial column")
+	}
+
+	// Serials mixed with text dates are read too, unless another value is neither
+	serials.AddRow([]string{"03/04/2024"})
+	if !IsExcelSerialColumn(serials, "Date") {
+		t.Error("Expected serials mixed with dates to be an Excel serial column")
+	}
+	result, err := NormalizeDates(serials, "Date", DateOptions{DayFirst: true, ExcelSerial: true})
+	if err != nil || len(result.Unparseable) != 0 || result.Table.Rows[0][0] != "2024-04-03" || result.Table.Rows[2][0] != "2024-04-03" {
+		t.Errorf("Expected both dates converted, got %v (unparseable %v, err %v)", result.Table, result.Unparseable, err)
+	}
+	serials.AddRow([]string{"12"})
+	if IsExcelSerialColumn(serials, "Date") {
+		t.Error("Expected a number outside the serial range to rule the column out")
+	}
+}
@@ -0,0 +1,310 @@
+package cleaner
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// FillStrategy defines how missing cells in a column are filled
+type FillStrategy string
+
+const (
+	FillConstant     FillStrategy = "constant"      // Use a fixed value
+	FillForward      FillStrategy = "ffill"         // Copy the previous non-missing value
+	FillBackward     FillStrategy = "bfill"         // Copy the next non-missing value
+	FillMean         FillStrategy = "mean"          // Column mean (numeric columns)
+	FillMedian       FillStrategy = "median"        // Column median (numeric columns)
+	FillMode         FillStrategy = "mode"          // Most frequent number (numeric columns)
+	FillMostFrequent FillStrategy = "most_frequent" // Most frequent category
+)
+
+// FillStrategies lists all strategies in display order
+var FillStrategies = []FillStrategy{
+	FillConstant,
+	FillForward,
+	FillBackward,
+	FillMean,
+	FillMedian,
+	FillMode,
+	FillMostFrequent,
+}
+
+// FillRule describes how to fill missing values in one column
+type FillRule struct {
+	Column   string       `json:"column"`             // Header of the column to fill
+	Strategy FillStrategy `json:"strategy"`           // Fill strategy
+	Value    string       `json:"value,omitempty"`    // Constant value for FillConstant
+	GroupBy  string       `json:"group_by,omitempty"` // Optional header to compute statistics per group
+}
+
+// FillResult contains the filled table and the cells that were imputed
+type FillResult struct {
+	Table   *models.DataTable
+	Imputed []models.CellRef
+}
+
+// FillMissing fills missing cells according to the given rules
+// Rules are applied in order, so a later rule sees values filled by an earlier one
+func FillMissing(dt *models.DataTable, rules []FillRule) (FillResult, error) {
+	if dt == nil {
+		return FillResult{}, fmt.Errorf("no data to fill")
+	}
+
+	result := FillResult{Table: dt.Clone()}
+
+	for _, rule := range rules {
+		colIdx := result.Table.ColumnIndex(rule.Column)
+		if colIdx == -1 {
+			return FillResult{}, fmt.Errorf("column %q not found", rule.Column)
+		}
+
+		groupIdx := -1
+		if rule.GroupBy != "" {
+			groupIdx = result.Table.ColumnIndex(rule.GroupBy)
+			if groupIdx == -1 {
+				return FillResult{}, fmt.Errorf("group column %q not found", rule.GroupBy)
+			}
+		}
+
+		filled, err := fillColumn(result.Table, colIdx, groupIdx, rule)
+		if err != nil {
+			return FillResult{}, err
+		}
+		result.Imputed = append(result.Imputed, filled...)
+	}
+
+	return result, nil
+}
+
+// AddImputedIndicators appends a "<column>_imputed" column with 1/0 flags
+// for every column that has at least one imputed cell
+// An indicator column that already exists is updated instead of added again
+func AddImputedIndicators(dt *models.DataTable, imputed []models.CellRef) *models.DataTable {
+	result := dt.Clone()
+
+	byCol := make(map[int]map[int]bool)
+	for _, ref := range imputed {
+		if byCol[ref.Col] == nil {
+			byCol[ref.Col] = make(map[int]bool)
+		}
+		byCol[ref.Col][ref.Row] = true
+	}
+
+	for colIdx := 0; colIdx < dt.ColumnCount(); colIdx++ {
+		rows, ok := byCol[colIdx]
+		if !ok {
+			continue
+		}
+
+		name := dt.Headers[colIdx] + "_imputed"
+		if indicator := result.ColumnIndex(name); indicator >= 0 {
+			for i := range rows {
+				if i < len(result.Rows) {
+					result.Rows[i] = padCells(result.Rows[i], len(result.Headers))
+					result.Rows[i][indicator] = "1"
+				}
+			}
+			continue
+		}
+
+		result.Headers = append(result.Headers, name)
+		for i := range result.Rows {
+			flag := "0"
+			if rows[i] {
+				flag = "1"
+			}
+			result.Rows[i] = append(padCells(result.Rows[i], len(result.Headers)-1), flag)
+		}
+	}
+
+	return result
+}
+
+// fillColumn fills one column in place and returns the imputed cells
+func fillColumn(dt *models.DataTable, colIdx, groupIdx int, rule FillRule) ([]models.CellRef, error) {
+	switch rule.Strategy {
+	case FillConstant:
+		return fillWith(dt, colIdx, func(int) (string, bool) {
+			return rule.Value, true
+		}), nil
+
+	case FillForward:
+		return fillForward(dt, colIdx), nil
+
+	case FillBackward:
+		return fillBackward(dt, colIdx), nil
+
+	case FillMean, FillMedian, FillMode, FillMostFrequent:
+		groups := groupValues(dt, colIdx, groupIdx)
+		stats := make(map[string]string, len(groups))
+		for key, values := range groups {
+			if len(values) == 0 {
+				// Nothing to learn from, leave this group's cells missing
+				continue
+			}
+			stat, err := columnStatistic(values, rule.Strategy)
+			if err != nil {
+				return nil, fmt.Errorf("column %q: %w", rule.Column, err)
+			}
+			stats[key] = stat
+		}
+
+		return fillWith(dt, colIdx, func(rowIdx int) (string, bool) {
+			value, ok := stats[groupKey(dt.Rows[rowIdx], groupIdx)]
+			return value, ok
+		}), nil
+
+	default:
+		return nil, fmt.Errorf("unknown fill strategy %q", rule.Strategy)
+	}
+}
+
+// fillWith fills each missing cell with the value returned by valueFor
+func fillWith(dt *models.DataTable, colIdx int, valueFor func(rowIdx int) (string, bool)) []models.CellRef {
+	var imputed []models.CellRef
+	for i, row := range dt.Rows {
+		if colIdx >= len(row) || !dt.IsMissing(colIdx, row[colIdx]) {
+			continue
+		}
+		if value, ok := valueFor(i); ok {
+			row[colIdx] = value
+			imputed = append(imputed, models.CellRef{Row: i, Col: colIdx})
+		}
+	}
+	return imputed
+}
+
+// fillForward copies the last seen value down into missing cells
+func fillForward(dt *models.DataTable, colIdx int) []models.CellRef {
+	var imputed []models.CellRef
+	last, seen := "", false
+	for i, row := range dt.Rows {
+		if colIdx >= len(row) {
+			continue
+		}
+		if !dt.IsMissing(colIdx, row[colIdx]) {
+			last, seen = row[colIdx], true
+			continue
+		}
+		if seen {
+			row[colIdx] = last
+			imputed = append(imputed, models.CellRef{Row: i, Col: colIdx})
+		}
+	}
+	return imputed
+}
+
+// fillBackward copies the next seen value up into missing cells
+func fillBackward(dt *models.DataTable, colIdx int) []models.CellRef {
+	var imputed []models.CellRef
+	next, seen := "", false
+	for i := len(dt.Rows) - 1; i >= 0; i-- {
+		row := dt.Rows[i]
+		if colIdx >= len(row) {
+			continue
+		}
+		if !dt.IsMissing(colIdx, row[colIdx]) {
+			next, seen = row[colIdx], true
+			continue
+		}
+		if seen {
+			row[colIdx] = next
+			imputed = append(imputed, models.CellRef{Row: i, Col: colIdx})
+		}
+	}
+
+	// Keep imputed cells in row order
+	sort.Slice(imputed, func(a, b int) bool { return imputed[a].Row < imputed[b].Row })
+	return imputed
+}
+
+// groupValues collects non-missing values of a column, keyed by group
+func groupValues(dt *models.DataTable, colIdx, groupIdx int) map[string][]string {
+	groups := make(map[string][]string)
+	for _, row := range dt.Rows {
+		key := groupKey(row, groupIdx)
+		if _, ok := groups[key]; !ok {
+			groups[key] = nil
+		}
+		if colIdx < len(row) && !dt.IsMissing(colIdx, row[colIdx]) {
+			groups[key] = append(groups[key], row[colIdx])
+		}
+	}
+	return groups
+}
+
+// groupKey returns the group value of a row, or "" when not grouping
+func groupKey(row []string, groupIdx int) string {
+	if groupIdx < 0 || groupIdx >= len(row) {
+		return ""
+	}
+	return row[groupIdx]
+}
+
+// columnStatistic computes the fill value for a statistical strategy
+func columnStatistic(values []string, strategy FillStrategy) (string, error) {
+	if strategy == FillMostFrequent {
+		return mostFrequent(values), nil
+	}
+
+	numbers := make([]float64, 0, len(values))
+	for _, v := range values {
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return "", fmt.Errorf("value %q is not numeric, cannot use %s", v, strategy)
+		}
+		numbers = append(numbers, n)
+	}
+
+	switch strategy {
+	case FillMean:
+		total := 0.0
+		for _, n := range numbers {
+			total += n
+		}
+		return formatNumber(total / float64(len(numbers))), nil
+
+	case FillMedian:
+		sort.Float64s(numbers)
+		mid := len(numbers) / 2
+		if len(numbers)%2 == 0 {
+			return formatNumber((numbers[mid-1] + numbers[mid]) / 2), nil
+		}
+		return formatNumber(numbers[mid]), nil
+
+	default: // FillMode
+		counts := make(map[float64]int)
+		for _, n := range numbers {
+			counts[n]++
+		}
+		best, bestCount := 0.0, 0
+		for n, c := range counts {
+			if c > bestCount || (c == bestCount && n < best) {
+				best, bestCount = n, c
+			}
+		}
+		return formatNumber(best), nil
+	}
+}
+
+// mostFrequent returns the most common value (first to reach the top count wins ties)
+func mostFrequent(values []string) string {
+	counts := make(map[string]int)
+	best, bestCount := "", 0
+	for _, v := range values {
+		counts[v]++
+		if counts[v] > bestCount {
+			best, bestCount = v, counts[v]
+		}
+	}
+	return best
+}
+
+// formatNumber formats a float without trailing zeros
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
@@ -0,0 +1,152 @@
+package cleaner
+
+import (
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func TestFillMissingConstant(t *testing.T) {
+	dt := models.NewDataTable([]string{"Name", "City"})
+	dt.AddRow([]string{"John", ""})
+	dt.AddRow([]string{"Jane", "LA"})
+
+	got, err := FillMissing(dt, []FillRule{{Column: "City", Strategy: FillConstant, Value: "Unknown"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got.Table.Rows[0][1] != "Unknown" {
+		t.Errorf("Expected 'Unknown', got %q", got.Table.Rows[0][1])
+	}
+
+	if len(got.Imputed) != 1 || got.Imputed[0] != (models.CellRef{Row: 0, Col: 1}) {
+		t.Errorf("Unexpected imputed cells: %v", got.Imputed)
+	}
+
+	if dt.Rows[0][1] != "" {
+		t.Error("Original table was modified")
+	}
+}
+
+func TestFillMissingForwardBackward(t *testing.T) {
+	dt := models.NewDataTable([]string{"A", "B"})
+	dt.AddRow([]string{"", "1"})
+	dt.AddRow([]string{"x", ""})
+	dt.AddRow([]string{"", ""})
+	dt.AddRow([]string{"y", "4"})
+
+	got, err := FillMissing(dt, []FillRule{
+		{Column: "A", Strategy: FillForward},
+		{Column: "B", Strategy: FillBackward},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantA := []string{"", "x", "x", "y"}
+	wantB := []string{"1", "4", "4", "4"}
+	for i, row := range got.Table.Rows {
+		if row[0] != wantA[i] || row[1] != wantB[i] {
+			t.Errorf("row %d: want [%s %s], got %v", i, wantA[i], wantB[i], row)
+		}
+	}
+
+	if len(got.Imputed) != 3 {
+		t.Errorf("Expected 3 imputed cells, got %d", len(got.Imputed))
+	}
+}
+
+func TestFillMissingStatistics(t *testing.T) {
+	dt := models.NewDataTable([]string{"Price"})
+	dt.AddRow([]string{"1"})
+	dt.AddRow([]string{"2"})
+	dt.AddRow([]string{"2"})
+	dt.AddRow([]string{"7"})
+	dt.AddRow([]string{""})
+
+	tests := []struct {
+		strategy FillStrategy
+		want     string
+	}{
+		{FillMean, "3"},
+		{FillMedian, "2"},
+		{FillMode, "2"},
+		{FillMostFrequent, "2"},
+	}
+
+	for _, tt := range tests {
+		got, err := FillMissing(dt, []FillRule{{Column: "Price", Strategy: tt.strategy}})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.strategy, err)
+		}
+		if got.Table.Rows[4][0] != tt.want {
+			t.Errorf("%s: want %s, got %s", tt.strategy, tt.want, got.Table.Rows[4][0])
+		}
+	}
+}
+
+func TestFillMissingGroupBy(t *testing.T) {
+	dt := models.NewDataTable([]string{"Category", "Price"})
+	dt.AddRow([]string{"A", "10"})
+	dt.AddRow([]string{"A", "20"})
+	dt.AddRow([]string{"A", ""})
+	dt.AddRow([]string{"B", "100"})
+	dt.AddRow([]string{"B", ""})
+
+	got, err := FillMissing(dt, []FillRule{{Column: "Price", Strategy: FillMedian, GroupBy: "Category"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got.Table.Rows[2][1] != "15" {
+		t.Errorf("Expected group A median 15, got %s", got.Table.Rows[2][1])
+	}
+
+	if got.Table.Rows[4][1] != "100" {
+		t.Errorf("Expected group B median 100, got %s", got.Table.Rows[4][1])
+	}
+}
+
+func TestFillMissingErrors(t *testing.T) {
+	dt := models.NewDataTable([]string{"Name"})
+	dt.AddRow([]string{"John"})
+	dt.AddRow([]string{""})
+
+	if _, err := FillMissing(dt, []FillRule{{Column: "Missing", Strategy: FillMean}}); err == nil {
+		t.Error("Expected error for unknown column")
+	}
+
+	if _, err := FillMissing(dt, []FillRule{{Column: "Name", Strategy: FillMean}}); err == nil {
+		t.Error("Expected error for mean on non-numeric column")
+	}
+
+	if _, err := FillMissing(dt, []FillRule{{Column: "Name", Strategy: "bogus"}}); err == nil {
+		t.Error("Expected error for unknown strategy")
+	}
+}
+
+func TestAddImputedIndicators(t *testing.T) {
+	dt := models.NewDataTable([]string{"Name", "Age"})
+	dt.AddRow([]string{"John", "30"})
+	dt.AddRow([]string{"Jane", "30"})
+
+	got := AddImputedIndicators(dt, []models.CellRef{{Row: 1, Col: 1}})
+
+	if got.ColumnCount() != 3 || got.Headers[2] != "Age_imputed" {
+		t.Fatalf("Unexpected headers: %v", got.Headers)
+	}
+
+	if got.Rows[0][2] != "0" || got.Rows[1][2] != "1" {
+		t.Errorf("Unexpected indicator values: %v, %v", got.Rows[0], got.Rows[1])
+	}
+
+	// A second call updates the existing indicator column
+	again := AddImputedIndicators(got, []models.CellRef{{Row: 0, Col: 1}})
+	if again.ColumnCount() != 3 {
+		t.Fatalf("Expected no duplicate indicator column, got %v", again.Headers)
+	}
+	if again.Rows[0][2] != "1" || again.Rows[1][2] != "1" {
+		t.Errorf("Unexpected indicator values: %v, %v", again.Rows[0], again.Rows[1])
+	}
+}
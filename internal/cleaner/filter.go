@@ -0,0 +1,269 @@
+package cleaner
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/expr"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// FilterOperator defines how a condition compares a cell with its value
+type FilterOperator string
+
+const (
+	OpEquals     FilterOperator = "equals"
+	OpNotEquals  FilterOperator = "not_equals"
+	OpContains   FilterOperator = "contains"
+	OpRegex      FilterOperator = "regex"
+	OpGreater    FilterOperator = "gt"  // numeric or date comparison, text otherwise
+	OpGreaterEq  FilterOperator = "gte" // numeric or date comparison, text otherwise
+	OpLess       FilterOperator = "lt"  // numeric or date comparison, text otherwise
+	OpLessEq     FilterOperator = "lte" // numeric or date comparison, text otherwise
+	OpIn         FilterOperator = "in"
+	OpIsMissing  FilterOperator = "is_missing"
+	OpNotMissing FilterOperator = "not_missing"
+)
+
+// FilterAction defines what happens to matching rows
+type FilterAction string
+
+const (
+	FilterKeep   FilterAction = "keep"   // Keep matching rows, drop the rest
+	FilterRemove FilterAction = "remove" // Drop matching rows
+)
+
+// Condition tests one column of a row
+type Condition struct {
+	Column     string         `json:"column"`
+	Operator   FilterOperator `json:"operator"`
+	Value      string         `json:"value,omitempty"`
+	Values     []string       `json:"values,omitempty"`      // Candidates for OpIn
+	IgnoreCase bool           `json:"ignore_case,omitempty"` // Case-insensitive text comparison
+}
+
+// Filter combines conditions, nested groups and an optional expression
+type Filter struct {
+	Any        bool         `json:"any,omitempty"`        // OR the parts together instead of AND
+	Conditions []Condition  `json:"conditions,omitempty"` // Column conditions
+	Groups     []Filter     `json:"groups,omitempty"`     // Nested filters, e.g. (a OR b) AND c
+	Expression string       `json:"expression,omitempty"` // Expression such as: city == "Ankara" and price > 100
+	Action     FilterAction `json:"action,omitempty"`     // Defaults to FilterKeep
+}
+
+// matcher tests a row against a compiled filter
+type matcher func(row []string) (bool, error)
+
+// MatchingRows returns the indices of rows matching the filter
+func MatchingRows(dt *models.DataTable, f Filter) ([]int, error) {
+	if dt == nil {
+		return nil, fmt.Errorf("no data to filter")
+	}
+
+	match, err := compileFilter(dt, f)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]int, 0)
+	for i, row := range dt.Rows {
+		ok, err := match(row)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i+1, err)
+		}
+		if ok {
+			indices = append(indices, i)
+		}
+	}
+
+	return indices, nil
+}
+
+// FilterRows keeps or removes matching rows depending on the filter action
+// Returns the new table and the number of rows removed
+func FilterRows(dt *models.DataTable, f Filter) (*models.DataTable, int, error) {
+	indices, err := MatchingRows(dt, f)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	matched := make(map[int]bool, len(indices))
+	for _, idx := range indices {
+		matched[idx] = true
+	}
+
+	keepMatches := f.Action != FilterRemove
+	result := dt.Clone()
+	result.Rows = make([][]string, 0, len(dt.Rows))
+	for i, row := range dt.Rows {
+		if matched[i] == keepMatches {
+			result.Rows = append(result.Rows, append([]string(nil), row...))
+		}
+	}
+
+	return result, dt.RowCount() - result.RowCount(), nil
+}
+
+// compileFilter turns a filter into a matcher, validating columns and patterns
+func compileFilter(dt *models.DataTable, f Filter) (matcher, error) {
+	if f.Action != "" && f.Action != FilterKeep && f.Action != FilterRemove {
+		return nil, fmt.Errorf("unknown filter action %q", f.Action)
+	}
+
+	var parts []matcher
+
+	for _, c := range f.Conditions {
+		m, err := compileCondition(dt, c)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, m)
+	}
+
+	for _, g := range f.Groups {
+		m, err := compileFilter(dt, g)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, m)
+	}
+
+	if strings.TrimSpace(f.Expression) != "" {
+		program, err := expr.Compile(f.Expression, dt.Headers)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter expression: %w", err)
+		}
+		parts = append(parts, program.WithNulls(dt.IsMissing).Match)
+	}
+
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("filter has no conditions")
+	}
+
+	return func(row []string) (bool, error) {
+		for _, part := range parts {
+			ok, err := part(row)
+			if err != nil {
+				return false, err
+			}
+			if ok && f.Any {
+				return true, nil
+			}
+			if !ok && !f.Any {
+				return false, nil
+			}
+		}
+		return !f.Any, nil
+	}, nil
+}
+
+// compileCondition builds a matcher for one column condition
+func compileCondition(dt *models.DataTable, c Condition) (matcher, error) {
+	colIdx := dt.ColumnIndex(c.Column)
+	if colIdx == -1 {
+		return nil, fmt.Errorf("column %q not found", c.Column)
+	}
+
+	cell := func(row []string) string {
+		if colIdx < len(row) {
+			return row[colIdx]
+		}
+		return ""
+	}
+	fold := func(s string) string {
+		if c.IgnoreCase {
+			return strings.ToLower(s)
+		}
+		return s
+	}
+
+	switch c.Operator {
+	case OpEquals, OpNotEquals:
+		want := fold(c.Value)
+		negate := c.Operator == OpNotEquals
+		return func(row []string) (bool, error) {
+			return (fold(cell(row)) == want) != negate, nil
+		}, nil
+
+	case OpContains:
+		want := fold(c.Value)
+		return func(row []string) (bool, error) {
+			return strings.Contains(fold(cell(row)), want), nil
+		}, nil
+
+	case OpRegex:
+		pattern := c.Value
+		if c.IgnoreCase {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression for %q: %w", c.Column, err)
+		}
+		return func(row []string) (bool, error) {
+			return re.MatchString(cell(row)), nil
+		}, nil
+
+	case OpGreater, OpGreaterEq, OpLess, OpLessEq:
+		return func(row []string) (bool, error) {
+			value := cell(row)
+			if dt.IsMissing(colIdx, value) {
+				return false, nil
+			}
+			cmp := compareValues(fold(value), fold(c.Value))
+			switch c.Operator {
+			case OpGreater:
+				return cmp > 0, nil
+			case OpGreaterEq:
+				return cmp >= 0, nil
+			case OpLess:
+				return cmp < 0, nil
+			default:
+				return cmp <= 0, nil
+			}
+		}, nil
+
+	case OpIn:
+		set := make(map[string]bool, len(c.Values))
+		for _, v := range c.Values {
+			set[fold(v)] = true
+		}
+		return func(row []string) (bool, error) {
+			return set[fold(cell(row))], nil
+		}, nil
+
+	case OpIsMissing, OpNotMissing:
+		negate := c.Operator == OpNotMissing
+		return func(row []string) (bool, error) {
+			return dt.IsMissing(colIdx, cell(row)) != negate, nil
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown filter operator %q", c.Operator)
+	}
+}
+
+// compareValues orders two cells numerically, then as dates, then as text
+func compareValues(a, b string) int {
+	if an, err := strconv.ParseFloat(strings.TrimSpace(a), 64); err == nil {
+		if bn, err := strconv.ParseFloat(strings.TrimSpace(b), 64); err == nil {
+			switch {
+			case an < bn:
+				return -1
+			case an > bn:
+				return 1
+			}
+			return 0
+		}
+	}
+
+	if at, _, ok := parseDate(a, true); ok {
+		if bt, _, ok := parseDate(b, true); ok {
+			return at.Compare(bt)
+		}
+	}
+
+	return strings.Compare(a, b)
+}
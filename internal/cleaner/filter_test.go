@@ -0,0 +1,123 @@
+package cleaner
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func newFilterTable() *models.DataTable {
+	dt := models.NewDataTable([]string{"Name", "City", "Price", "Date"})
+	dt.AddRow([]string{"Ali", "Ankara", "150", "2024-03-01"})
+	dt.AddRow([]string{"Ayşe", "İzmir", "90", "2024-01-15"})
+	dt.AddRow([]string{"Bob", "ankara", "1000", ""})
+	dt.AddRow([]string{"Can", "Bursa", "", "2023-12-31"})
+	return dt
+}
+
+func TestMatchingRowsConditions(t *testing.T) {
+	dt := newFilterTable()
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []int
+	}{
+		{"equals ignore case", Filter{Conditions: []Condition{{Column: "City", Operator: OpEquals, Value: "ANKARA", IgnoreCase: true}}}, []int{0, 2}},
+		{"numeric gt", Filter{Conditions: []Condition{{Column: "Price", Operator: OpGreater, Value: "100"}}}, []int{0, 2}},
+		{"date lt", Filter{Conditions: []Condition{{Column: "Date", Operator: OpLess, Value: "2024-02-01"}}}, []int{1, 3}},
+		{"regex", Filter{Conditions: []Condition{{Column: "Name", Operator: OpRegex, Value: "^A"}}}, []int{0, 1}},
+		{"in", Filter{Conditions: []Condition{{Column: "City", Operator: OpIn, Values: []string{"Bursa", "İzmir"}}}}, []int{1, 3}},
+		{"is missing", Filter{Conditions: []Condition{{Column: "Price", Operator: OpIsMissing}}}, []int{3}},
+		{"and", Filter{Conditions: []Condition{
+			{Column: "City", Operator: OpContains, Value: "nkara"},
+			{Column: "Price", Operator: OpLessEq, Value: "500"},
+		}}, []int{0}},
+		{"or", Filter{Any: true, Conditions: []Condition{
+			{Column: "Name", Operator: OpEquals, Value: "Can"},
+			{Column: "Price", Operator: OpGreaterEq, Value: "1000"},
+		}}, []int{2, 3}},
+		{"group", Filter{
+			Conditions: []Condition{{Column: "Date", Operator: OpNotMissing}},
+			Groups: []Filter{{Any: true, Conditions: []Condition{
+				{Column: "City", Operator: OpEquals, Value: "Bursa"},
+				{Column: "City", Operator: OpEquals, Value: "Ankara"},
+			}}},
+		}, []int{0, 3}},
+		{"expression", Filter{Expression: `Price > 100 and City != "Ankara"`}, []int{2}},
+	}
+
+	for _, tt := range tests {
+		got, err := MatchingRows(dt, tt.filter)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: want %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestFilterExpressionHonorsNullMarkers(t *testing.T) {
+	dt := newFilterTable()
+	dt.Nulls = &models.NullMarkers{Global: []string{"N/A"}}
+	dt.Rows[0][2] = "N/A"
+
+	condition, err := MatchingRows(dt, Filter{Conditions: []Condition{{Column: "Price", Operator: OpIsMissing}}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expression, err := MatchingRows(dt, Filter{Expression: "isnull(Price)"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []int{0, 3}
+	if !reflect.DeepEqual(condition, want) || !reflect.DeepEqual(expression, want) {
+		t.Errorf("want %v from both, got %v and %v", want, condition, expression)
+	}
+}
+
+func TestFilterRowsKeepAndRemove(t *testing.T) {
+	dt := newFilterTable()
+	f := Filter{Conditions: []Condition{{Column: "City", Operator: OpEquals, Value: "Ankara", IgnoreCase: true}}}
+
+	kept, removed, err := FilterRows(dt, f)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if kept.RowCount() != 2 || removed != 2 {
+		t.Errorf("Expected 2 kept and 2 removed, got %d and %d", kept.RowCount(), removed)
+	}
+
+	f.Action = FilterRemove
+	rest, removed, _ := FilterRows(dt, f)
+	if rest.RowCount() != 2 || removed != 2 || rest.Rows[0][0] != "Ayşe" {
+		t.Errorf("Unexpected rows after remove: %v", rest.Rows)
+	}
+
+	if dt.RowCount() != 4 {
+		t.Error("Original table was modified")
+	}
+}
+
+func TestFilterErrors(t *testing.T) {
+	dt := newFilterTable()
+
+	bad := []Filter{
+		{},
+		{Conditions: []Condition{{Column: "Nope", Operator: OpEquals}}},
+		{Conditions: []Condition{{Column: "Name", Operator: "like"}}},
+		{Conditions: []Condition{{Column: "Name", Operator: OpRegex, Value: "("}}},
+		{Expression: "Price >"},
+		{Expression: "Name == 'x'", Action: "archive"},
+	}
+
+	for i, f := range bad {
+		if _, err := MatchingRows(dt, f); err == nil {
+			t.Errorf("filter %d: expected error", i)
+		}
+	}
+}
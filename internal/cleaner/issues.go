@@ -0,0 +1,99 @@
+package cleaner
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// IssueKind defines what kind of problem an issue is
+type IssueKind string
+
+const (
+	IssueMissing     IssueKind = "missing"
+	IssueDuplicate   IssueKind = "duplicate"
+	IssueEmptyRow    IssueKind = "empty_row"
+	IssueEmptyColumn IssueKind = "empty_column"
+	IssueOutlier     IssueKind = "outlier"
+	IssueRule        IssueKind = "rule"
+)
+
+// Severity ranks how serious an issue is
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+// Issue is one problem found by validation
+// Row is -1 for column-level issues, Column is empty for row-level issues
+type Issue struct {
+	Row      int       `json:"row"`
+	Column   string    `json:"column,omitempty"`
+	Kind     IssueKind `json:"kind"`
+	Severity Severity  `json:"severity"`
+	Message  string    `json:"message"`
+}
+
+// RuleIssues converts rule violations into issues
+func RuleIssues(violations []Violation) []Issue {
+	issues := make([]Issue, len(violations))
+	for i, v := range violations {
+		issues[i] = Issue{
+			Row:      v.Row,
+			Column:   v.Column,
+			Kind:     IssueRule,
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("%s: %s", v.Rule, v.Message),
+		}
+	}
+	return issues
+}
+
+// SortIssues orders issues by position: row first, then column order in the table
+func SortIssues(dt *models.DataTable, issues []Issue) {
+	// Like ColumnIndex: the first column with a header, -1 for row-level issues
+	index := make(map[string]int, len(dt.Headers))
+	for i := len(dt.Headers) - 1; i >= 0; i-- {
+		index[dt.Headers[i]] = i
+	}
+	column := func(header string) int {
+		if i, ok := index[header]; ok {
+			return i
+		}
+		return -1
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Row != issues[j].Row {
+			return issues[i].Row < issues[j].Row
+		}
+		return column(issues[i].Column) < column(issues[j].Column)
+	})
+}
+
+// PageIssues returns one page of issues (0-based) and the number of pages
+func PageIssues(issues []Issue, page, size int) ([]Issue, int) {
+	if size <= 0 || len(issues) == 0 {
+		return nil, 0
+	}
+
+	pages := (len(issues) + size - 1) / size
+	page = max(0, min(page, pages-1))
+	start := page * size
+	end := min(start+size, len(issues))
+
+	return issues[start:end], pages
+}
+
+// Cell returns the position of the issue in the table, false for row or column-level issues
+func (i Issue) Cell(dt *models.DataTable) (models.CellRef, bool) {
+	colIdx := dt.ColumnIndex(i.Column)
+	if i.Row < 0 || colIdx < 0 {
+		return models.CellRef{}, false
+	}
+	return models.CellRef{Row: i.Row, Col: colIdx}, true
+}
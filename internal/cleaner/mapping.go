@@ -0,0 +1,251 @@
+package cleaner
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// ValueCount is a distinct value of a column and how often it occurs
+type ValueCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// ValueMapping maps values of a column to canonical values
+type ValueMapping struct {
+	Column     string            `json:"column"`
+	Values     map[string]string `json:"values"`                // Original value -> canonical value
+	IgnoreCase bool              `json:"ignore_case,omitempty"` // Match originals case-insensitively, ignoring surrounding spaces
+}
+
+// Cluster is a group of values that look like spellings of the same thing
+type Cluster struct {
+	Canonical string       `json:"canonical"` // Most frequent value of the group
+	Values    []ValueCount `json:"values"`
+}
+
+// DistinctValues returns the distinct values of a column, most frequent first
+func DistinctValues(dt *models.DataTable, column string) ([]ValueCount, error) {
+	if dt == nil {
+		return nil, fmt.Errorf("no data loaded")
+	}
+
+	colIdx := dt.ColumnIndex(column)
+	if colIdx == -1 {
+		return nil, fmt.Errorf("column %q not found", column)
+	}
+
+	counts := make(map[string]int)
+	var order []string
+	for _, row := range dt.Rows {
+		value := ""
+		if colIdx < len(row) {
+			value = row[colIdx]
+		}
+		if counts[value] == 0 {
+			order = append(order, value)
+		}
+		counts[value]++
+	}
+
+	values := make([]ValueCount, len(order))
+	for i, value := range order {
+		values[i] = ValueCount{Value: value, Count: counts[value]}
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Count > values[j].Count
+	})
+
+	return values, nil
+}
+
+// MapValues replaces values of a column using a mapping table
+// Returns the new table and the number of cells changed
+func MapValues(dt *models.DataTable, mapping ValueMapping) (*models.DataTable, int, error) {
+	if dt == nil {
+		return nil, 0, fmt.Errorf("no data loaded")
+	}
+
+	colIdx := dt.ColumnIndex(mapping.Column)
+	if colIdx == -1 {
+		return nil, 0, fmt.Errorf("column %q not found", mapping.Column)
+	}
+
+	lookup := mapping.Values
+	if mapping.IgnoreCase {
+		lookup = make(map[string]string, len(mapping.Values))
+		for from, to := range mapping.Values {
+			lookup[mappingKey(from)] = to
+		}
+	}
+
+	result := dt.Clone()
+	changed := 0
+	for i, row := range result.Rows {
+		if colIdx >= len(row) {
+			continue
+		}
+
+		key := row[colIdx]
+		if mapping.IgnoreCase {
+			key = mappingKey(key)
+		}
+		if to, ok := lookup[key]; ok && to != row[colIdx] {
+			result.Rows[i][colIdx] = to
+			changed++
+		}
+	}
+
+	return result, changed, nil
+}
+
+// MaxSpellingCandidates caps the distinct spellings SuggestClusters compares pairwise
+// for typos and abbreviations; values beyond it are still grouped by fingerprint
+const MaxSpellingCandidates = 1000
+
+// SuggestClusters groups values that differ only in case, punctuation, word
+// order or a small typo, and values that abbreviate another (ACTV, Active)
+// Only groups with more than one value are returned, largest first
+// Values are expected most frequent first, as DistinctValues returns them
+func SuggestClusters(values []ValueCount) []Cluster {
+	type group struct {
+		key     string
+		members []ValueCount
+	}
+
+	// Values with the same fingerprint always belong together
+	var groups []*group
+	byKey := make(map[string]*group)
+	for _, v := range values {
+		key := fingerprint(v.Value)
+		if key == "" {
+			continue
+		}
+		g, ok := byKey[key]
+		if !ok {
+			g = &group{key: key}
+			byKey[key] = g
+			groups = append(groups, g)
+		}
+		g.members = append(g.members, v)
+	}
+
+	// Merge groups whose fingerprints are close spellings
+	// Only the most frequent groups are compared, and only within the same first letter
+	// (abbreviations keep it and typos rarely change it), so large columns stay fast
+	merged := make([]bool, len(groups))
+	blocks := make(map[rune][]int)
+	for i, g := range groups[:min(len(groups), MaxSpellingCandidates)] {
+		first := []rune(g.key)[0]
+		blocks[first] = append(blocks[first], i)
+	}
+	for _, block := range blocks {
+		for n, i := range block {
+			if merged[i] {
+				continue
+			}
+			a := groups[i]
+			for _, j := range block[n+1:] {
+				if !merged[j] && similarSpelling(a.key, groups[j].key) {
+					a.members = append(a.members, groups[j].members...)
+					merged[j] = true
+				}
+			}
+		}
+	}
+
+	var clusters []Cluster
+	for i, g := range groups {
+		if merged[i] || len(g.members) < 2 {
+			continue
+		}
+		sort.SliceStable(g.members, func(a, b int) bool {
+			return g.members[a].Count > g.members[b].Count
+		})
+		clusters = append(clusters, Cluster{Canonical: g.members[0].Value, Values: g.members})
+	}
+
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusterSize(clusters[i]) > clusterSize(clusters[j])
+	})
+
+	return clusters
+}
+
+// Helper functions
+
+// mappingKey normalizes a value for case-insensitive lookups
+func mappingKey(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// fingerprint reduces a value to lowercase ASCII-folded words, sorted
+func fingerprint(value string) string {
+	words := strings.FieldsFunc(foldTurkish(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
+
+// similarSpelling reports whether two fingerprints look like the same word
+func similarSpelling(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) > len(rb) {
+		ra, rb = rb, ra
+	}
+
+	// Abbreviation: same first letter and all letters appear in order
+	if len(ra) >= 3 && ra[0] == rb[0] && isSubsequence(ra, rb) {
+		return true
+	}
+
+	// Typo: at most one edit per five characters (the length difference alone counts as edits)
+	return len(ra) >= 4 && (len(rb)-len(ra))*5 <= len(rb) && levenshtein(ra, rb)*5 <= len(rb)
+}
+
+// isSubsequence reports whether all runes of a appear in b in order
+func isSubsequence(a, b []rune) bool {
+	i := 0
+	for _, r := range b {
+		if i < len(a) && a[i] == r {
+			i++
+		}
+	}
+	return i == len(a)
+}
+
+// levenshtein returns the edit distance between two rune slices
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func clusterSize(c Cluster) int {
+	total := 0
+	for _, v := range c.Values {
+		total += v.Count
+	}
+	return total
+}
@@ -0,0 +1,102 @@
+package cleaner
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func TestDistinctValues(t *testing.T) {
+	dt := models.NewDataTable([]string{"Status"})
+	for _, v := range []string{"Active", "active", "Active", "", "Closed", "Active"} {
+		dt.AddRow([]string{v})
+	}
+
+	values, err := DistinctValues(dt, "Status")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(values) != 4 {
+		t.Fatalf("Expected 4 distinct values, got %v", values)
+	}
+	if values[0] != (ValueCount{Value: "Active", Count: 3}) {
+		t.Errorf("Expected most frequent value first, got %v", values[0])
+	}
+
+	if _, err := DistinctValues(dt, "Missing"); err == nil {
+		t.Error("Expected error for unknown column")
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	dt := models.NewDataTable([]string{"Status", "Note"})
+	dt.AddRow([]string{"ACTV", "ACTV"})
+	dt.AddRow([]string{" aktif ", "x"})
+	dt.AddRow([]string{"Closed", "y"})
+
+	mapping := ValueMapping{
+		Column:     "Status",
+		Values:     map[string]string{"actv": "Active", "Aktif": "Active"},
+		IgnoreCase: true,
+	}
+	got, changed, err := MapValues(dt, mapping)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if changed != 2 || got.Rows[0][0] != "Active" || got.Rows[1][0] != "Active" || got.Rows[2][0] != "Closed" {
+		t.Errorf("Unexpected result (%d changed): %v", changed, got.Rows)
+	}
+	if got.Rows[0][1] != "ACTV" {
+		t.Errorf("Expected other columns unchanged, got %s", got.Rows[0][1])
+	}
+
+	// Exact matching by default
+	mapping.IgnoreCase = false
+	if _, changed, _ := MapValues(dt, mapping); changed != 0 {
+		t.Errorf("Expected no exact matches, got %d", changed)
+	}
+}
+
+func TestSuggestClusters(t *testing.T) {
+	values := []ValueCount{
+		{"Active", 10},
+		{"active", 4},
+		{"ACTV", 2},
+		{"Actve", 1},
+		{"Closed", 5},
+		{"Ankara", 3},
+		{"Doe, John", 2},
+		{"John Doe", 1},
+	}
+
+	clusters := SuggestClusters(values)
+	if len(clusters) != 2 {
+		t.Fatalf("Expected 2 clusters, got %v", clusters)
+	}
+
+	if clusters[0].Canonical != "Active" || len(clusters[0].Values) != 4 {
+		t.Errorf("Expected Active cluster with 4 values, got %v", clusters[0])
+	}
+	if clusters[1].Canonical != "Doe, John" || len(clusters[1].Values) != 2 {
+		t.Errorf("Expected name cluster, got %v", clusters[1])
+	}
+}
+
+func TestSuggestClustersManyValues(t *testing.T) {
+	var values []ValueCount
+	for i := 0; i < MaxSpellingCandidates*3; i++ {
+		values = append(values, ValueCount{fmt.Sprintf("customer %d", i), 1})
+	}
+	// Beyond the spelling candidates, but still grouped by fingerprint
+	values = append(values, ValueCount{"Zed Ltd", 1}, ValueCount{"ZED LTD.", 1})
+
+	for _, c := range SuggestClusters(values) {
+		if c.Canonical == "Zed Ltd" && len(c.Values) == 2 {
+			return
+		}
+	}
+	t.Error("Expected the Zed Ltd cluster")
+}
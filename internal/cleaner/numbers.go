@@ -0,0 +1,233 @@
+package cleaner
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// NumberOptions configures numeric normalization
+type NumberOptions struct {
+	UnitColumn     string `json:"unit_column,omitempty"`     // Header of a new column receiving stripped currency/unit symbols (empty drops them)
+	KeepPercentage bool   `json:"keep_percentage,omitempty"` // Keep "45%" as 45 instead of converting to 0.45
+}
+
+// NumberResult contains the normalized table and a report of what was found
+type NumberResult struct {
+	Table              *models.DataTable
+	DecimalSeparator   string           // "." or "," as detected for the column
+	ThousandsSeparator string           // Detected grouping separator (empty if none seen)
+	Converted          int              // Number of cells converted
+	Unparseable        []models.CellRef // Non-missing cells that could not be parsed (left unchanged)
+}
+
+// numberPattern splits a value into prefix symbols, the number and suffix symbols
+var numberPattern = regexp.MustCompile(`^([^\d+\-.,(]*?)\s*(\(?[+\-]?\s*[\d][\d.,' \x{00A0}]*\)?)\s*([^\d]*)$`)
+
+// NormalizeNumbers converts locale-formatted numbers in a column (e.g. "1.234,56 TL",
+// "$1,234.56", "%45") into canonical numbers like 1234.56
+// Cells that cannot be parsed are left unchanged and reported in NumberResult.Unparseable
+func NormalizeNumbers(dt *models.DataTable, column string, opts NumberOptions) (NumberResult, error) {
+	if dt == nil {
+		return NumberResult{}, fmt.Errorf("no data to normalize")
+	}
+
+	colIdx := dt.ColumnIndex(column)
+	if colIdx == -1 {
+		return NumberResult{}, fmt.Errorf("column %q not found", column)
+	}
+	if opts.UnitColumn != "" && dt.ColumnIndex(opts.UnitColumn) != -1 {
+		return NumberResult{}, fmt.Errorf("column %q already exists", opts.UnitColumn)
+	}
+
+	values, _ := dt.GetColumn(colIdx)
+	decimal, thousands := detectSeparators(values)
+
+	result := NumberResult{
+		Table:              dt.Clone(),
+		DecimalSeparator:   decimal,
+		ThousandsSeparator: thousands,
+	}
+
+	if opts.UnitColumn != "" {
+		result.Table.Headers = append(result.Table.Headers, opts.UnitColumn)
+	}
+
+	for i, row := range result.Table.Rows {
+		unit := ""
+		if colIdx < len(row) && !result.Table.IsMissing(colIdx, row[colIdx]) {
+			number, symbol, ok := parseLocaleNumber(row[colIdx], decimal, opts.KeepPercentage)
+			if ok {
+				row[colIdx] = formatNumber(number)
+				unit = symbol
+				result.Converted++
+			} else {
+				result.Unparseable = append(result.Unparseable, models.CellRef{Row: i, Col: colIdx})
+			}
+		}
+
+		if opts.UnitColumn != "" {
+			result.Table.Rows[i] = append(row, unit)
+		}
+	}
+
+	return result, nil
+}
+
+// detectSeparators votes over all values of a column to decide which character
+// is the decimal separator; unambiguous values like "1.234,5" outweigh the default "."
+func detectSeparators(values []string) (decimal, thousands string) {
+	commaVotes, dotVotes := 0, 0
+	var groupings = make(map[string]int)
+
+	for _, v := range values {
+		m := numberPattern.FindStringSubmatch(strings.TrimSpace(v))
+		if m == nil {
+			continue
+		}
+		digits := strings.Trim(m[2], "()+- ")
+
+		lastDot := strings.LastIndex(digits, ".")
+		lastComma := strings.LastIndex(digits, ",")
+		dots := strings.Count(digits, ".")
+		commas := strings.Count(digits, ",")
+
+		switch {
+		case dots > 0 && commas > 0:
+			// Whichever comes last is the decimal separator
+			if lastComma > lastDot {
+				commaVotes++
+				groupings["."]++
+			} else {
+				dotVotes++
+				groupings[","]++
+			}
+		case commas > 1:
+			dotVotes++
+			groupings[","]++
+		case dots > 1:
+			commaVotes++
+			groupings["."]++
+		case commas == 1 && len(digits)-lastComma-1 != 3:
+			commaVotes++
+		case dots == 1 && len(digits)-lastDot-1 != 3:
+			dotVotes++
+		}
+
+		if strings.ContainsAny(digits, " \u00a0") {
+			groupings[" "]++
+		}
+		if strings.Contains(digits, "'") {
+			groupings["'"]++
+		}
+	}
+
+	decimal = "."
+	if commaVotes > dotVotes {
+		decimal = ","
+	}
+
+	best := 0
+	for sep, count := range groupings {
+		if sep != decimal && count > best {
+			thousands, best = sep, count
+		}
+	}
+
+	return decimal, thousands
+}
+
+// parseLocaleNumber parses one value using the given decimal separator
+// Returns the number and any currency/unit symbol that surrounded it
+func parseLocaleNumber(value, decimal string, keepPercentage bool) (float64, string, bool) {
+	m := numberPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return 0, "", false
+	}
+
+	prefix, digits, suffix := strings.TrimSpace(m[1]), strings.TrimSpace(m[2]), strings.TrimSpace(m[3])
+
+	// Accounting style negatives: (1.234,56)
+	negative := false
+	if strings.HasPrefix(digits, "(") {
+		if !strings.HasSuffix(digits, ")") {
+			return 0, "", false
+		}
+		negative = true
+		digits = strings.Trim(digits, "()")
+	} else if strings.HasSuffix(digits, ")") {
+		return 0, "", false
+	}
+
+	// Percent sign may lead (Turkish "%45") or trail ("45%")
+	percent := false
+	if strings.HasPrefix(prefix, "%") {
+		percent = true
+		prefix = strings.TrimSpace(strings.TrimPrefix(prefix, "%"))
+	}
+	if strings.HasSuffix(suffix, "%") {
+		percent = true
+		suffix = strings.TrimSpace(strings.TrimSuffix(suffix, "%"))
+	}
+
+	// Drop grouping characters, then turn the decimal separator into "."
+	grouping := ","
+	if decimal == "," {
+		grouping = "."
+	}
+	if !validGrouping(digits, grouping, decimal) {
+		return 0, "", false
+	}
+	digits = strings.NewReplacer(grouping, "", " ", "", "\u00a0", "", "'", "").Replace(digits)
+	if decimal == "," {
+		digits = strings.Replace(digits, ",", ".", 1)
+	}
+
+	number, err := strconv.ParseFloat(digits, 64)
+	if err != nil {
+		return 0, "", false
+	}
+
+	if negative {
+		number = -number
+	}
+	if percent && !keepPercentage {
+		number /= 100
+	}
+
+	symbol := strings.TrimSpace(prefix + " " + suffix)
+	return number, symbol, true
+}
+
+// validGrouping checks that grouping characters split the integer part into groups of three
+// and that nothing but digits follows a single decimal separator
+// "12,5" in a column with a "." decimal is not 125, and "1,234.56" in a column with a ","
+// decimal is not 1.23456, so both are rejected rather than guessed
+func validGrouping(digits, grouping, decimal string) bool {
+	integer := strings.TrimLeft(digits, "+- ")
+	if i := strings.Index(integer, decimal); i >= 0 {
+		if strings.ContainsAny(integer[i+1:], decimal+grouping) {
+			return false
+		}
+		integer = integer[:i]
+	}
+
+	groups := strings.FieldsFunc(integer, func(r rune) bool {
+		return string(r) == grouping || r == ' ' || r == '\u00a0' || r == '\''
+	})
+	if len(groups) < 2 {
+		return true
+	}
+	if len(groups[0]) > 3 {
+		return false
+	}
+	for _, group := range groups[1:] {
+		if len(group) != 3 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+package cleaner
+
+import (
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func TestNormalizeNumbersDecimalComma(t *testing.T) {
+	dt := models.NewDataTable([]string{"Price"})
+	dt.AddRow([]string{"1.234,56 TL"})
+	dt.AddRow([]string{"₺12,5"})
+	dt.AddRow([]string{"1.000"})
+	dt.AddRow([]string{"(2.500,00)"})
+	dt.AddRow([]string{"abc"})
+	dt.AddRow([]string{"$1,234.56"}) // separators in the other order are not 1.23456
+	dt.AddRow([]string{"1,2,5"})     // more than one decimal separator
+
+	got, err := NormalizeNumbers(dt, "Price", NumberOptions{UnitColumn: "Price_unit"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got.DecimalSeparator != "," || got.ThousandsSeparator != "." {
+		t.Errorf("Expected ',' decimal and '.' thousands, got %q and %q", got.DecimalSeparator, got.ThousandsSeparator)
+	}
+
+	want := [][]string{
+		{"1234.56", "TL"},
+		{"12.5", "₺"},
+		{"1000", ""},
+		{"-2500", ""},
+		{"abc", ""},
+		{"$1,234.56", ""},
+		{"1,2,5", ""},
+	}
+	for i, w := range want {
+		if got.Table.Rows[i][0] != w[0] || got.Table.Rows[i][1] != w[1] {
+			t.Errorf("row %d: want %v, got %v", i, w, got.Table.Rows[i])
+		}
+	}
+
+	if got.Converted != 4 || len(got.Unparseable) != 3 || got.Unparseable[0].Row != 4 {
+		t.Errorf("Expected 4 converted and rows 4-6 unparseable, got %d and %v", got.Converted, got.Unparseable)
+	}
+}
+
+func TestNormalizeNumbersDecimalDotAndPercent(t *testing.T) {
+	dt := models.NewDataTable([]string{"Value"})
+	dt.AddRow([]string{"$1,234.56"})
+	dt.AddRow([]string{"45%"})
+	dt.AddRow([]string{"%12,5"})
+	dt.AddRow([]string{"1,000,000"})
+
+	got, err := NormalizeNumbers(dt, "Value", NumberOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got.DecimalSeparator != "." {
+		t.Errorf("Expected '.' decimal, got %q", got.DecimalSeparator)
+	}
+
+	// "%12,5" has no valid grouping with the column's "." decimal, so it is left for review
+	want := []string{"1234.56", "0.45", "%12,5", "1000000"}
+	for i, w := range want {
+		if got.Table.Rows[i][0] != w {
+			t.Errorf("row %d: want %s, got %s", i, w, got.Table.Rows[i][0])
+		}
+	}
+
+	if len(got.Unparseable) != 1 || got.Unparseable[0].Row != 2 {
+		t.Errorf("Expected row 2 unparseable, got %v", got.Unparseable)
+	}
+
+	if got.Table.ColumnCount() != 1 {
+		t.Errorf("Expected no unit column, got %v", got.Table.Headers)
+	}
+}
+
+func TestNormalizeNumbersErrors(t *testing.T) {
+	dt := models.NewDataTable([]string{"Value", "Unit"})
+
+	if _, err := NormalizeNumbers(dt, "Missing", NumberOptions{}); err == nil {
+		t.Error("Expected error for unknown column")
+	}
+
+	if _, err := NormalizeNumbers(dt, "Value", NumberOptions{UnitColumn: "Unit"}); err == nil {
+		t.Error("Expected error for existing unit column")
+	}
+}
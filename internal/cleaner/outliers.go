@@ -0,0 +1,276 @@
+package cleaner
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// OutlierMethod defines how the normal range of a column is computed
+type OutlierMethod string
+
+const (
+	OutlierIQR       OutlierMethod = "iqr"             // Q1 - k*IQR .. Q3 + k*IQR
+	OutlierZScore    OutlierMethod = "zscore"          // mean ± k*stddev
+	OutlierModifiedZ OutlierMethod = "modified_zscore" // median ± k*MAD/0.6745
+	OutlierBounds    OutlierMethod = "bounds"          // fixed lower and upper bounds
+)
+
+// OutlierMethods lists the available detection methods
+var OutlierMethods = []OutlierMethod{OutlierIQR, OutlierZScore, OutlierModifiedZ, OutlierBounds}
+
+// OutlierAction defines what happens to detected outliers
+type OutlierAction string
+
+const (
+	OutlierCap    OutlierAction = "cap"    // Clamp the value to the nearest bound
+	OutlierNull   OutlierAction = "null"   // Blank the cell
+	OutlierRemove OutlierAction = "remove" // Drop the row
+)
+
+// minOutlierValues is the smallest sample the statistical methods work on
+const minOutlierValues = 4
+
+// OutlierOptions configures outlier detection for one column
+type OutlierOptions struct {
+	Column    string        `json:"column"`
+	Method    OutlierMethod `json:"method"`
+	Threshold float64       `json:"threshold,omitempty"` // k for the method (defaults: iqr 1.5, zscore 3, modified_zscore 3.5)
+	Lower     *float64      `json:"lower,omitempty"`     // Lower bound for OutlierBounds
+	Upper     *float64      `json:"upper,omitempty"`     // Upper bound for OutlierBounds
+}
+
+// Outlier is a cell outside the normal range of its column
+type Outlier struct {
+	Row   int     `json:"row"`
+	Value string  `json:"value"`
+	Score float64 `json:"score"` // Distance from the range in units of the method (0 for bounds)
+}
+
+// OutlierReport lists the outliers found in one column
+type OutlierReport struct {
+	Column   string        `json:"column"`
+	Method   OutlierMethod `json:"method"`
+	Lower    float64       `json:"lower"`
+	Upper    float64       `json:"upper"`
+	Outliers []Outlier     `json:"outliers"`
+}
+
+// NumericColumns returns the headers of columns whose non-missing values are all numbers
+func NumericColumns(dt *models.DataTable) []string {
+	var headers []string
+	if dt == nil {
+		return headers
+	}
+
+	for colIdx, header := range dt.Headers {
+		numeric, seen := true, false
+		for _, row := range dt.Rows {
+			if colIdx >= len(row) || dt.IsMissing(colIdx, row[colIdx]) {
+				continue
+			}
+			if _, ok := parseNumber(row[colIdx]); !ok {
+				numeric = false
+				break
+			}
+			seen = true
+		}
+		if numeric && seen {
+			headers = append(headers, header)
+		}
+	}
+
+	return headers
+}
+
+// DetectOutliers finds the values of a column outside its normal range
+// Non-numeric and missing cells are ignored
+func DetectOutliers(dt *models.DataTable, opts OutlierOptions) (OutlierReport, error) {
+	if dt == nil {
+		return OutlierReport{}, fmt.Errorf("no data loaded")
+	}
+
+	colIdx := dt.ColumnIndex(opts.Column)
+	if colIdx == -1 {
+		return OutlierReport{}, fmt.Errorf("column %q not found", opts.Column)
+	}
+
+	var rows []int
+	var numbers []float64
+	for i, row := range dt.Rows {
+		if colIdx >= len(row) || dt.IsMissing(colIdx, row[colIdx]) {
+			continue
+		}
+		if n, ok := parseNumber(row[colIdx]); ok {
+			rows = append(rows, i)
+			numbers = append(numbers, n)
+		}
+	}
+	if len(numbers) == 0 {
+		return OutlierReport{}, fmt.Errorf("column %q has no numeric values", opts.Column)
+	}
+
+	report := OutlierReport{Column: opts.Column, Method: opts.Method}
+	var scale float64
+	var err error
+	report.Lower, report.Upper, scale, err = outlierRange(numbers, opts)
+	if err != nil {
+		return OutlierReport{}, err
+	}
+
+	for i, n := range numbers {
+		if n >= report.Lower && n <= report.Upper {
+			continue
+		}
+		score := 0.0
+		if scale > 0 {
+			score = math.Max(report.Lower-n, n-report.Upper) / scale
+		}
+		report.Outliers = append(report.Outliers, Outlier{
+			Row:   rows[i],
+			Value: dt.Rows[rows[i]][colIdx],
+			Score: score,
+		})
+	}
+
+	return report, nil
+}
+
+// HandleOutliers caps, blanks or removes the outliers of a column
+// Returns the new table, the report it acted on and the number of cells or rows changed
+func HandleOutliers(dt *models.DataTable, opts OutlierOptions, action OutlierAction) (*models.DataTable, OutlierReport, error) {
+	report, err := DetectOutliers(dt, opts)
+	if err != nil {
+		return nil, report, err
+	}
+
+	colIdx := dt.ColumnIndex(opts.Column)
+	result := dt.Clone()
+
+	switch action {
+	case OutlierCap:
+		for _, o := range report.Outliers {
+			n, _ := parseNumber(o.Value)
+			result.Rows[o.Row][colIdx] = formatNumber(math.Min(math.Max(n, report.Lower), report.Upper))
+		}
+
+	case OutlierNull:
+		for _, o := range report.Outliers {
+			result.Rows[o.Row][colIdx] = ""
+		}
+
+	case OutlierRemove:
+		drop := make(map[int]bool, len(report.Outliers))
+		for _, o := range report.Outliers {
+			drop[o.Row] = true
+		}
+		result.Rows = make([][]string, 0, len(dt.Rows)-len(drop))
+		for i, row := range dt.Rows {
+			if !drop[i] {
+				result.Rows = append(result.Rows, append([]string(nil), row...))
+			}
+		}
+
+	default:
+		return nil, report, fmt.Errorf("unknown outlier action %q", action)
+	}
+
+	return result, report, nil
+}
+
+// outlierRange returns the normal range of the values and the unit used for scores
+func outlierRange(numbers []float64, opts OutlierOptions) (lower, upper, scale float64, err error) {
+	k := opts.Threshold
+	inf := math.Inf(1)
+
+	switch opts.Method {
+	case OutlierBounds:
+		if opts.Lower == nil && opts.Upper == nil {
+			return 0, 0, 0, fmt.Errorf("bounds method needs a lower or upper bound")
+		}
+		lower, upper = -inf, inf
+		if opts.Lower != nil {
+			lower = *opts.Lower
+		}
+		if opts.Upper != nil {
+			upper = *opts.Upper
+		}
+		return lower, upper, 0, nil
+
+	case OutlierIQR, OutlierZScore, OutlierModifiedZ:
+		if len(numbers) < minOutlierValues {
+			return -inf, inf, 0, nil
+		}
+
+	default:
+		return 0, 0, 0, fmt.Errorf("unknown outlier method %q", opts.Method)
+	}
+
+	sorted := append([]float64(nil), numbers...)
+	sort.Float64s(sorted)
+
+	switch opts.Method {
+	case OutlierIQR:
+		if k <= 0 {
+			k = 1.5
+		}
+		q1, q3 := quantile(sorted, 0.25), quantile(sorted, 0.75)
+		scale = q3 - q1
+		if scale == 0 {
+			// Most values are equal; any other value would be flagged, so flag none
+			return -inf, inf, 0, nil
+		}
+		return q1 - k*scale, q3 + k*scale, scale, nil
+
+	case OutlierZScore:
+		if k <= 0 {
+			k = 3
+		}
+		mean := 0.0
+		for _, n := range numbers {
+			mean += n
+		}
+		mean /= float64(len(numbers))
+		variance := 0.0
+		for _, n := range numbers {
+			variance += (n - mean) * (n - mean)
+		}
+		scale = math.Sqrt(variance / float64(len(numbers)))
+		return mean - k*scale, mean + k*scale, scale, nil
+
+	default: // OutlierModifiedZ
+		if k <= 0 {
+			k = 3.5
+		}
+		median := quantile(sorted, 0.5)
+		deviations := make([]float64, len(sorted))
+		for i, n := range sorted {
+			deviations[i] = math.Abs(n - median)
+		}
+		sort.Float64s(deviations)
+		scale = quantile(deviations, 0.5) / 0.6745
+		if scale == 0 {
+			return -inf, inf, 0, nil
+		}
+		return median - k*scale, median + k*scale, scale, nil
+	}
+}
+
+// quantile returns the q-th quantile of sorted values using linear interpolation
+func quantile(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(pos-float64(lo))
+}
+
+// parseNumber parses a plain finite number, ignoring surrounding spaces
+// "NaN" and "Inf" are text here, they would poison statistics and JSON output
+func parseNumber(value string) (float64, bool) {
+	n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	return n, err == nil && !math.IsNaN(n) && !math.IsInf(n, 0)
+}
@@ -0,0 +1,117 @@
+package cleaner
+
+import (
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func outlierTable() *models.DataTable {
+	dt := models.NewDataTable([]string{"Name", "Price"})
+	for i, price := range []string{"10", "12", "11", "13", "", "500", "9", "-300"} {
+		dt.AddRow([]string{string(rune('A' + i)), price})
+	}
+	return dt
+}
+
+func TestNumericColumns(t *testing.T) {
+	got := NumericColumns(outlierTable())
+	if len(got) != 1 || got[0] != "Price" {
+		t.Errorf("Expected [Price], got %v", got)
+	}
+}
+
+func TestDetectOutliersMethods(t *testing.T) {
+	dt := outlierTable()
+	lower, upper := 0.0, 100.0
+
+	tests := []struct {
+		name     string
+		opts     OutlierOptions
+		expected []int
+	}{
+		{"iqr", OutlierOptions{Column: "Price", Method: OutlierIQR}, []int{5, 7}},
+		{"modified zscore", OutlierOptions{Column: "Price", Method: OutlierModifiedZ}, []int{5, 7}},
+		{"zscore", OutlierOptions{Column: "Price", Method: OutlierZScore, Threshold: 1.5}, []int{5, 7}},
+		{"bounds", OutlierOptions{Column: "Price", Method: OutlierBounds, Lower: &lower, Upper: &upper}, []int{5, 7}},
+		{"upper bound only", OutlierOptions{Column: "Price", Method: OutlierBounds, Upper: &upper}, []int{5}},
+	}
+
+	for _, tt := range tests {
+		report, err := DetectOutliers(dt, tt.opts)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if len(report.Outliers) != len(tt.expected) {
+			t.Errorf("%s: expected rows %v, got %v", tt.name, tt.expected, report.Outliers)
+			continue
+		}
+		for i, o := range report.Outliers {
+			if o.Row != tt.expected[i] {
+				t.Errorf("%s: expected row %d, got %d", tt.name, tt.expected[i], o.Row)
+			}
+		}
+	}
+
+	if _, err := DetectOutliers(dt, OutlierOptions{Column: "Price", Method: OutlierBounds}); err == nil {
+		t.Error("Expected error for bounds without limits")
+	}
+	if _, err := DetectOutliers(dt, OutlierOptions{Column: "Name", Method: OutlierIQR}); err == nil {
+		t.Error("Expected error for non-numeric column")
+	}
+}
+
+func TestHandleOutliers(t *testing.T) {
+	dt := outlierTable()
+	upper := 100.0
+	opts := OutlierOptions{Column: "Price", Method: OutlierBounds, Upper: &upper}
+
+	capped, _, err := HandleOutliers(dt, opts, OutlierCap)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if capped.Rows[5][1] != "100" || capped.Rows[7][1] != "-300" {
+		t.Errorf("Expected 500 capped to 100, got %v", capped.Rows)
+	}
+
+	nulled, _, _ := HandleOutliers(dt, opts, OutlierNull)
+	if nulled.Rows[5][1] != "" {
+		t.Errorf("Expected outlier blanked, got %s", nulled.Rows[5][1])
+	}
+
+	removed, report, _ := HandleOutliers(dt, opts, OutlierRemove)
+	if removed.RowCount() != dt.RowCount()-1 || len(report.Outliers) != 1 {
+		t.Errorf("Expected 1 row removed, got %d rows", removed.RowCount())
+	}
+
+	if dt.Rows[5][1] != "500" {
+		t.Error("Expected original table unchanged")
+	}
+}
+
+func TestValidateDataOutliers(t *testing.T) {
+	result := ValidateData(outlierTable())
+	if result.OutlierCount != 2 || len(result.Outliers) != 1 {
+		t.Errorf("Expected 2 outliers in 1 column, got %d in %v", result.OutlierCount, result.Outliers)
+	}
+	if result.TotalIssues != result.MissingValueCount {
+		t.Errorf("Expected outliers left out of the total, got %d", result.TotalIssues)
+	}
+}
+
+func TestDetectOutliersZeroSpread(t *testing.T) {
+	dt := models.NewDataTable([]string{"Qty"})
+	for _, qty := range []string{"1", "1", "1", "1", "1", "1", "1", "1", "2", "3"} {
+		dt.AddRow([]string{qty})
+	}
+
+	for _, method := range []OutlierMethod{OutlierIQR, OutlierModifiedZ} {
+		report, err := DetectOutliers(dt, OutlierOptions{Column: "Qty", Method: method})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", method, err)
+		}
+		if len(report.Outliers) != 0 {
+			t.Errorf("%s: expected no outliers when the spread is 0, got %v", method, report.Outliers)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package cleaner
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// ReplaceOptions configures a bulk find/replace operation
+type ReplaceOptions struct {
+	Find       string   `json:"find"`                  // Text or regular expression to search for
+	Replace    string   `json:"replace"`               // Replacement; may reference capture groups ($1, ${name}) in regex mode
+	Columns    []string `json:"columns,omitempty"`     // Headers to search (empty means all columns)
+	Regex      bool     `json:"regex,omitempty"`       // Treat Find as a regular expression
+	IgnoreCase bool     `json:"ignore_case,omitempty"` // Case-insensitive matching
+	WholeCell  bool     `json:"whole_cell,omitempty"`  // Only match when the whole cell matches
+}
+
+// ReplaceSample shows one cell before and after replacement
+type ReplaceSample struct {
+	Row    int
+	Column string
+	Before string
+	After  string
+}
+
+// ReplacePreview summarizes what a find/replace would change
+type ReplacePreview struct {
+	Matches int             // Total number of matches
+	Cells   int             // Number of cells that would change
+	Samples []ReplaceSample // First few changed cells
+}
+
+// PreviewReplace reports the matches of a find/replace without changing the table
+func PreviewReplace(dt *models.DataTable, opts ReplaceOptions, sampleLimit int) (ReplacePreview, error) {
+	var preview ReplacePreview
+
+	err := forEachReplacement(dt, opts, func(rowIdx, colIdx, matches int, before, after string) {
+		preview.Matches += matches
+		preview.Cells++
+		if len(preview.Samples) < sampleLimit {
+			preview.Samples = append(preview.Samples, ReplaceSample{
+				Row:    rowIdx,
+				Column: dt.Headers[colIdx],
+				Before: before,
+				After:  after,
+			})
+		}
+	})
+
+	return preview, err
+}
+
+// FindReplace replaces matches in the selected columns and returns the new table
+// and the number of changed cells
+func FindReplace(dt *models.DataTable, opts ReplaceOptions) (*models.DataTable, int, error) {
+	if dt == nil {
+		return nil, 0, fmt.Errorf("no data to search")
+	}
+
+	result := dt.Clone()
+	changed := 0
+
+	err := forEachReplacement(dt, opts, func(rowIdx, colIdx, _ int, _, after string) {
+		result.Rows[rowIdx][colIdx] = after
+		changed++
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return result, changed, nil
+}
+
+// forEachReplacement calls fn for every cell whose value would change
+func forEachReplacement(dt *models.DataTable, opts ReplaceOptions, fn func(rowIdx, colIdx, matches int, before, after string)) error {
+	if dt == nil {
+		return nil
+	}
+
+	re, err := compileReplace(opts)
+	if err != nil {
+		return err
+	}
+
+	columns, err := resolveColumns(dt, opts.Columns)
+	if err != nil {
+		return err
+	}
+
+	for rowIdx, row := range dt.Rows {
+		for _, colIdx := range columns {
+			if colIdx >= len(row) {
+				continue
+			}
+			before := row[colIdx]
+			matches := len(re.FindAllStringIndex(before, -1))
+			if matches == 0 {
+				continue
+			}
+
+			var after string
+			if opts.Regex {
+				after = re.ReplaceAllString(before, opts.Replace)
+			} else {
+				after = re.ReplaceAllLiteralString(before, opts.Replace)
+			}
+			if after != before {
+				fn(rowIdx, colIdx, matches, before, after)
+			}
+		}
+	}
+
+	return nil
+}
+
+// compileReplace builds the search expression for the given options
+func compileReplace(opts ReplaceOptions) (*regexp.Regexp, error) {
+	if opts.Find == "" {
+		return nil, fmt.Errorf("search text is empty")
+	}
+
+	pattern := opts.Find
+	if !opts.Regex {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	if opts.WholeCell {
+		pattern = "^(?:" + pattern + ")$"
+	}
+	if opts.IgnoreCase {
+		pattern = "(?i)" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression: %w", err)
+	}
+	return re, nil
+}
+
+// resolveColumns maps headers to column indices (all columns when headers is empty)
+func resolveColumns(dt *models.DataTable, headers []string) ([]int, error) {
+	if len(headers) == 0 {
+		indices := make([]int, dt.ColumnCount())
+		for i := range indices {
+			indices[i] = i
+		}
+		return indices, nil
+	}
+
+	indices := make([]int, 0, len(headers))
+	for _, header := range headers {
+		idx := dt.ColumnIndex(header)
+		if idx == -1 {
+			return nil, fmt.Errorf("column %q not found", header)
+		}
+		indices = append(indices, idx)
+	}
+	return indices, nil
+}
@@ -0,0 +1,87 @@
+package cleaner
+
+import (
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func TestFindReplaceLiteral(t *testing.T) {
+	dt := models.NewDataTable([]string{"City", "Note"})
+	dt.AddRow([]string{"New York", "new"})
+	dt.AddRow([]string{"NEW Delhi", "a.b"})
+
+	got, changed, err := FindReplace(dt, ReplaceOptions{Find: "new", Replace: "Old", Columns: []string{"City"}, IgnoreCase: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if changed != 2 || got.Rows[0][0] != "Old York" || got.Rows[1][0] != "Old Delhi" {
+		t.Errorf("Unexpected result (%d changed): %v", changed, got.Rows)
+	}
+
+	if got.Rows[0][1] != "new" {
+		t.Errorf("Expected column outside scope unchanged, got %s", got.Rows[0][1])
+	}
+
+	// Literal mode must not interpret regex metacharacters
+	got, changed, _ = FindReplace(dt, ReplaceOptions{Find: ".", Replace: "-"})
+	if changed != 1 || got.Rows[1][1] != "a-b" {
+		t.Errorf("Expected only literal dot replaced, got %v", got.Rows)
+	}
+}
+
+func TestFindReplaceRegexCaptureGroups(t *testing.T) {
+	dt := models.NewDataTable([]string{"Name"})
+	dt.AddRow([]string{"Doe, John"})
+	dt.AddRow([]string{"Jane"})
+
+	got, changed, err := FindReplace(dt, ReplaceOptions{Find: `(\w+), (\w+)`, Replace: "$2 $1", Regex: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if changed != 1 || got.Rows[0][0] != "John Doe" {
+		t.Errorf("Unexpected result: %v", got.Rows)
+	}
+}
+
+func TestFindReplaceWholeCell(t *testing.T) {
+	dt := models.NewDataTable([]string{"Status"})
+	dt.AddRow([]string{"on"})
+	dt.AddRow([]string{"done"})
+
+	got, changed, _ := FindReplace(dt, ReplaceOptions{Find: "on", Replace: "active", WholeCell: true})
+
+	if changed != 1 || got.Rows[0][0] != "active" || got.Rows[1][0] != "done" {
+		t.Errorf("Unexpected result: %v", got.Rows)
+	}
+}
+
+func TestPreviewReplace(t *testing.T) {
+	dt := models.NewDataTable([]string{"Text"})
+	dt.AddRow([]string{"aaa"})
+	dt.AddRow([]string{"bab"})
+	dt.AddRow([]string{"ccc"})
+
+	preview, err := PreviewReplace(dt, ReplaceOptions{Find: "a", Replace: "x"}, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if preview.Matches != 4 || preview.Cells != 2 {
+		t.Errorf("Expected 4 matches in 2 cells, got %d in %d", preview.Matches, preview.Cells)
+	}
+
+	if len(preview.Samples) != 1 || preview.Samples[0].After != "xxx" {
+		t.Errorf("Unexpected samples: %v", preview.Samples)
+	}
+
+	if dt.Rows[0][0] != "aaa" {
+		t.Error("Preview modified the table")
+	}
+
+	if _, err := PreviewReplace(dt, ReplaceOptions{Find: "(", Regex: true}, 1); err == nil {
+		t.Error("Expected error for invalid regex")
+	}
+}
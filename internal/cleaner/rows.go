@@ -0,0 +1,93 @@
+package cleaner
+
+import (
+	"fmt"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// CellEdit is a manual change of a single cell
+type CellEdit struct {
+	Row    int    `json:"row"` // 0-based data row
+	Column string `json:"column"`
+	Old    string `json:"old"` // Value before the edit, checked when the edit is replayed
+	Value  string `json:"value"`
+}
+
+// EditCell returns a copy of the table with one cell changed
+// The cell must still hold the old value, so a replayed edit never overwrites different data
+// Only the edited row is copied; the others are shared with dt
+func EditCell(dt *models.DataTable, edit CellEdit) (*models.DataTable, error) {
+	colIdx, err := columnIndex(dt, edit.Column)
+	if err != nil {
+		return nil, err
+	}
+	if edit.Row < 0 || edit.Row >= dt.RowCount() {
+		return nil, fmt.Errorf("row %d out of range (1-%d)", edit.Row+1, dt.RowCount())
+	}
+
+	current := ""
+	if colIdx < len(dt.Rows[edit.Row]) {
+		current = dt.Rows[edit.Row][colIdx]
+	}
+	if current != edit.Old {
+		return nil, fmt.Errorf("row %d, %s: expected %q, found %q", edit.Row+1, edit.Column, edit.Old, current)
+	}
+
+	result := dt.ShallowClone()
+	row := padCells(append([]string(nil), dt.Rows[edit.Row]...), result.ColumnCount())
+	row[colIdx] = edit.Value
+	result.Rows[edit.Row] = row
+	return result, nil
+}
+
+// InsertRow returns a copy of the table with a row inserted before the given index
+// An index equal to the row count appends; missing values are left empty
+// The existing rows are shared with dt
+func InsertRow(dt *models.DataTable, at int, values []string) (*models.DataTable, error) {
+	if at < 0 || at > dt.RowCount() {
+		return nil, fmt.Errorf("row %d out of range (1-%d)", at+1, dt.RowCount()+1)
+	}
+	if len(values) > dt.ColumnCount() {
+		return nil, fmt.Errorf("row has %d values, table has %d columns", len(values), dt.ColumnCount())
+	}
+
+	result := dt.ShallowClone()
+	row := padCells(append([]string(nil), values...), result.ColumnCount())
+	result.Rows = append(result.Rows[:at], append([][]string{row}, dt.Rows[at:]...)...)
+	return result, nil
+}
+
+// DeleteRows returns a copy of the table without the given rows
+// The kept rows are shared with dt
+func DeleteRows(dt *models.DataTable, rows []int) (*models.DataTable, error) {
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no rows to delete")
+	}
+
+	remove := make(map[int]bool, len(rows))
+	for _, rowIdx := range rows {
+		if rowIdx < 0 || rowIdx >= dt.RowCount() {
+			return nil, fmt.Errorf("row %d out of range (1-%d)", rowIdx+1, dt.RowCount())
+		}
+		remove[rowIdx] = true
+	}
+
+	result := dt.ShallowClone()
+	kept := result.Rows[:0]
+	for rowIdx, row := range result.Rows {
+		if !remove[rowIdx] {
+			kept = append(kept, row)
+		}
+	}
+	result.Rows = kept
+	return result, nil
+}
+
+// padCells extends a row with empty cells up to the column count
+func padCells(row []string, columns int) []string {
+	for len(row) < columns {
+		row = append(row, "")
+	}
+	return row
+}
@@ -0,0 +1,90 @@
+package cleaner
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func rowsTable() *models.DataTable {
+	dt := models.NewDataTable([]string{"name", "city"})
+	dt.AddRow([]string{"Ali", "Ankara"})
+	dt.AddRow([]string{"Ayşe", "Izmir"})
+	dt.AddRow([]string{"Can", "Bursa"})
+	return dt
+}
+
+func TestEditCell(t *testing.T) {
+	dt := rowsTable()
+
+	result, err := EditCell(dt, CellEdit{Row: 1, Column: "city", Old: "Izmir", Value: "İzmir"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := result.Rows[1][1]; got != "İzmir" {
+		t.Errorf("Expected edited cell İzmir, got %q", got)
+	}
+	if dt.Rows[1][1] != "Izmir" {
+		t.Error("EditCell modified the original table")
+	}
+	if &result.Rows[0][0] != &dt.Rows[0][0] {
+		t.Error("Expected rows that were not edited to be shared")
+	}
+
+	// A replayed edit only applies while the cell still holds the old value
+	if _, err := EditCell(result, CellEdit{Row: 1, Column: "city", Old: "Izmir", Value: "X"}); err == nil {
+		t.Error("Expected error when the cell no longer holds the old value")
+	}
+	if _, err := EditCell(dt, CellEdit{Row: 3, Column: "city"}); err == nil {
+		t.Error("Expected error for a row out of range")
+	}
+	if _, err := EditCell(dt, CellEdit{Row: 0, Column: "missing"}); err == nil {
+		t.Error("Expected error for an unknown column")
+	}
+}
+
+func TestInsertAndDeleteRows(t *testing.T) {
+	dt := rowsTable()
+
+	result, err := InsertRow(dt, 1, []string{"Deniz"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := [][]string{{"Ali", "Ankara"}, {"Deniz", ""}, {"Ayşe", "Izmir"}, {"Can", "Bursa"}}
+	if !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("Expected rows %v, got %v", want, result.Rows)
+	}
+	if dt.RowCount() != 3 {
+		t.Error("InsertRow modified the original table")
+	}
+
+	appended, err := InsertRow(dt, 3, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := appended.Rows[3]; !reflect.DeepEqual(got, []string{"", ""}) {
+		t.Errorf("Expected an empty appended row, got %v", got)
+	}
+
+	result, err = DeleteRows(dt, []int{2, 0, 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := [][]string{{"Ayşe", "Izmir"}}; !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("Expected rows %v, got %v", want, result.Rows)
+	}
+
+	if _, err := InsertRow(dt, 5, nil); err == nil {
+		t.Error("Expected error for an insert position out of range")
+	}
+	if _, err := InsertRow(dt, 0, []string{"a", "b", "c"}); err == nil {
+		t.Error("Expected error for too many values")
+	}
+	if _, err := DeleteRows(dt, []int{3}); err == nil {
+		t.Error("Expected error for a row out of range")
+	}
+	if _, err := DeleteRows(dt, nil); err == nil {
+		t.Error("Expected error for no rows")
+	}
+}
@@ -0,0 +1,392 @@
+package cleaner
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/veliulugut/snapclean/internal/expr"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// RuleType defines what a validation rule checks
+type RuleType string
+
+const (
+	RuleRequired   RuleType = "required"   // Cell must not be missing
+	RuleUnique     RuleType = "unique"     // Values must not repeat
+	RuleDataType   RuleType = "type"       // Value must be of DataType
+	RuleMin        RuleType = "min"        // Number must be >= Min
+	RuleMax        RuleType = "max"        // Number must be <= Max
+	RuleLength     RuleType = "length"     // Text length within MinLength..MaxLength
+	RulePattern    RuleType = "pattern"    // Value must match Pattern
+	RuleAllowed    RuleType = "allowed"    // Value must be one of Values
+	RuleDateRange  RuleType = "date_range" // Date within After..Before
+	RuleCompare    RuleType = "compare"    // Column compared with Other using Operator, e.g. end_date >= start_date
+	RuleExpression RuleType = "expression" // Expression must be true for the row
+)
+
+// Data types accepted by RuleDataType
+const (
+	DataNumber  = "number"
+	DataInteger = "integer"
+	DataDate    = "date"
+	DataBool    = "bool"
+	DataEmail   = "email"
+)
+
+// Rule is one declarative validation rule
+// Only the fields used by its type need to be set
+type Rule struct {
+	Name       string   `json:"name,omitempty"` // Shown in violations (defaults to "<column> <type>")
+	Column     string   `json:"column,omitempty"`
+	Type       RuleType `json:"type"`
+	DataType   string   `json:"data_type,omitempty"`
+	Min        *float64 `json:"min,omitempty"`
+	Max        *float64 `json:"max,omitempty"`
+	MinLength  *int     `json:"min_length,omitempty"`
+	MaxLength  *int     `json:"max_length,omitempty"`
+	Pattern    string   `json:"pattern,omitempty"`
+	Values     []string `json:"values,omitempty"`
+	IgnoreCase bool     `json:"ignore_case,omitempty"` // For RuleAllowed
+	After      string   `json:"after,omitempty"`       // Earliest date for RuleDateRange
+	Before     string   `json:"before,omitempty"`      // Latest date for RuleDateRange
+	Operator   string   `json:"operator,omitempty"`    // ==, !=, <, <=, > or >= for RuleCompare
+	Other      string   `json:"other,omitempty"`       // Column compared against for RuleCompare
+	Expression string   `json:"expression,omitempty"`  // Condition for RuleExpression
+}
+
+// RuleSet is a list of rules stored in a file
+type RuleSet struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Violation is a cell breaking a rule
+type Violation struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column"`
+	Value   string `json:"value"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// DisplayName returns the rule name used in violations
+func (r Rule) DisplayName() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	if r.Column == "" {
+		return string(r.Type)
+	}
+	return r.Column + " " + string(r.Type)
+}
+
+// LoadRules reads a rule set from a JSON file
+func LoadRules(path string) (RuleSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return RuleSet{}, fmt.Errorf("failed to read rules: %w", err)
+	}
+
+	var rs RuleSet
+	if err := json.Unmarshal(data, &rs); err != nil {
+		return RuleSet{}, fmt.Errorf("failed to parse rules: %w", err)
+	}
+
+	return rs, nil
+}
+
+// Save writes the rule set to a JSON file
+func (rs RuleSet) Save(path string) error {
+	data, err := json.MarshalIndent(rs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode rules: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write rules: %w", err)
+	}
+
+	return nil
+}
+
+// cellCheck tests one row, returning a message when the rule is broken
+type cellCheck func(rowIdx int, row []string) (string, bool)
+
+// ValidateRules checks every row against the rules and returns the violations
+// in rule order. Apart from required, rules skip missing cells.
+func ValidateRules(dt *models.DataTable, rules []Rule) ([]Violation, error) {
+	if dt == nil {
+		return nil, fmt.Errorf("no data loaded")
+	}
+
+	violations := make([]Violation, 0)
+	for _, rule := range rules {
+		colIdx := -1
+		if rule.Type != RuleExpression || rule.Column != "" {
+			colIdx = dt.ColumnIndex(rule.Column)
+			if colIdx == -1 {
+				return nil, fmt.Errorf("rule %q: column %q not found", rule.DisplayName(), rule.Column)
+			}
+		}
+
+		check, err := compileRule(dt, rule, colIdx)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", rule.DisplayName(), err)
+		}
+
+		for i, row := range dt.Rows {
+			value := ""
+			if colIdx >= 0 && colIdx < len(row) {
+				value = row[colIdx]
+			}
+			if rule.Type != RuleRequired && rule.Type != RuleExpression && dt.IsMissing(colIdx, value) {
+				continue
+			}
+
+			if message, broken := check(i, row); broken {
+				violations = append(violations, Violation{
+					Row:     i,
+					Column:  rule.Column,
+					Value:   value,
+					Rule:    rule.DisplayName(),
+					Message: message,
+				})
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+// compileRule validates the rule settings and builds its check
+func compileRule(dt *models.DataTable, rule Rule, colIdx int) (cellCheck, error) {
+	cell := func(row []string) string {
+		if colIdx >= 0 && colIdx < len(row) {
+			return row[colIdx]
+		}
+		return ""
+	}
+
+	switch rule.Type {
+	case RuleRequired:
+		return func(_ int, row []string) (string, bool) {
+			return "value is required", dt.IsMissing(colIdx, cell(row))
+		}, nil
+
+	case RuleUnique:
+		first := make(map[string]int)
+		return func(rowIdx int, row []string) (string, bool) {
+			value := strings.TrimSpace(cell(row))
+			if prev, seen := first[value]; seen {
+				return fmt.Sprintf("duplicate of row %d", prev+1), true
+			}
+			first[value] = rowIdx
+			return "", false
+		}, nil
+
+	case RuleDataType:
+		valid, err := dataTypeCheck(rule.DataType)
+		if err != nil {
+			return nil, err
+		}
+		return func(_ int, row []string) (string, bool) {
+			return "not a valid " + rule.DataType, !valid(strings.TrimSpace(cell(row)))
+		}, nil
+
+	case RuleMin, RuleMax:
+		bound := rule.Min
+		if rule.Type == RuleMax {
+			bound = rule.Max
+		}
+		if bound == nil {
+			return nil, fmt.Errorf("%s rule needs a %s value", rule.Type, rule.Type)
+		}
+		return func(_ int, row []string) (string, bool) {
+			n, ok := parseNumber(cell(row))
+			switch {
+			case !ok:
+				return "not a number", true
+			case rule.Type == RuleMin && n < *bound:
+				return "below minimum " + formatNumber(*bound), true
+			case rule.Type == RuleMax && n > *bound:
+				return "above maximum " + formatNumber(*bound), true
+			}
+			return "", false
+		}, nil
+
+	case RuleLength:
+		if rule.MinLength == nil && rule.MaxLength == nil {
+			return nil, fmt.Errorf("length rule needs a minimum or maximum length")
+		}
+		return func(_ int, row []string) (string, bool) {
+			length := len([]rune(cell(row)))
+			if rule.MinLength != nil && length < *rule.MinLength {
+				return fmt.Sprintf("shorter than %d characters", *rule.MinLength), true
+			}
+			if rule.MaxLength != nil && length > *rule.MaxLength {
+				return fmt.Sprintf("longer than %d characters", *rule.MaxLength), true
+			}
+			return "", false
+		}, nil
+
+	case RulePattern:
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+		return func(_ int, row []string) (string, bool) {
+			return "does not match " + rule.Pattern, !re.MatchString(cell(row))
+		}, nil
+
+	case RuleAllowed:
+		if len(rule.Values) == 0 {
+			return nil, fmt.Errorf("allowed rule needs values")
+		}
+		allowed := make(map[string]bool, len(rule.Values))
+		for _, v := range rule.Values {
+			allowed[allowedKey(v, rule.IgnoreCase)] = true
+		}
+		return func(_ int, row []string) (string, bool) {
+			return "not an allowed value", !allowed[allowedKey(cell(row), rule.IgnoreCase)]
+		}, nil
+
+	case RuleDateRange:
+		after, before, err := dateRange(rule)
+		if err != nil {
+			return nil, err
+		}
+		return func(_ int, row []string) (string, bool) {
+			t, _, ok := parseDate(cell(row), true)
+			switch {
+			case !ok:
+				return "not a date", true
+			case !after.IsZero() && t.Before(after):
+				return "before " + rule.After, true
+			case !before.IsZero() && t.After(before):
+				return "after " + rule.Before, true
+			}
+			return "", false
+		}, nil
+
+	case RuleCompare:
+		otherIdx := dt.ColumnIndex(rule.Other)
+		if otherIdx == -1 {
+			return nil, fmt.Errorf("column %q not found", rule.Other)
+		}
+		holds, err := comparison(rule.Operator)
+		if err != nil {
+			return nil, err
+		}
+		message := fmt.Sprintf("not %s %s", rule.Operator, rule.Other)
+		return func(_ int, row []string) (string, bool) {
+			other := ""
+			if otherIdx < len(row) {
+				other = row[otherIdx]
+			}
+			if dt.IsMissing(otherIdx, other) {
+				return "", false
+			}
+			return message, !holds(compareValues(cell(row), other))
+		}, nil
+
+	case RuleExpression:
+		program, err := expr.Compile(rule.Expression, dt.Headers)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression: %w", err)
+		}
+		program = program.WithNulls(dt.IsMissing)
+		return func(_ int, row []string) (string, bool) {
+			ok, err := program.Match(row)
+			if err != nil {
+				return err.Error(), true
+			}
+			return "expression is false", !ok
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown rule type %q", rule.Type)
+}
+
+// dataTypeCheck returns the validator for a data type
+func dataTypeCheck(dataType string) (func(string) bool, error) {
+	switch dataType {
+	case DataNumber:
+		return func(v string) bool { _, ok := parseNumber(v); return ok }, nil
+	case DataInteger:
+		return func(v string) bool { _, err := strconv.ParseInt(v, 10, 64); return err == nil }, nil
+	case DataDate:
+		return func(v string) bool { _, _, ok := parseDate(v, true); return ok }, nil
+	case DataBool:
+		return func(v string) bool { _, ok := boolValues[strings.ToLower(v)]; return ok }, nil
+	case DataEmail:
+		return emailPattern.MatchString, nil
+	}
+	return nil, fmt.Errorf("unknown data type %q (use number, integer, date, bool or email)", dataType)
+}
+
+// MatchesType reports whether a value is of a data type (number, integer, date, bool or email)
+func MatchesType(dataType, value string) bool {
+	valid, err := dataTypeCheck(dataType)
+	return err == nil && valid(strings.TrimSpace(value))
+}
+
+// boolValues are the spellings accepted as booleans
+var boolValues = map[string]bool{
+	"true": true, "false": false, "yes": true, "no": false, "1": true, "0": false,
+	"evet": true, "hayır": false, "y": true, "n": false,
+}
+
+// emailPattern is a deliberately loose e-mail check
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// dateRange parses the bounds of a date range rule
+func dateRange(rule Rule) (after, before time.Time, err error) {
+	if rule.After == "" && rule.Before == "" {
+		return after, before, fmt.Errorf("date_range rule needs an after or before date")
+	}
+
+	var ok bool
+	if rule.After != "" {
+		if after, _, ok = parseDate(rule.After, true); !ok {
+			return after, before, fmt.Errorf("invalid after date %q", rule.After)
+		}
+	}
+	if rule.Before != "" {
+		if before, _, ok = parseDate(rule.Before, true); !ok {
+			return after, before, fmt.Errorf("invalid before date %q", rule.Before)
+		}
+	}
+	return after, before, nil
+}
+
+// comparison returns the test of a compareValues result for an operator
+func comparison(operator string) (func(cmp int) bool, error) {
+	switch operator {
+	case "==", "=":
+		return func(cmp int) bool { return cmp == 0 }, nil
+	case "!=", "<>":
+		return func(cmp int) bool { return cmp != 0 }, nil
+	case "<":
+		return func(cmp int) bool { return cmp < 0 }, nil
+	case "<=":
+		return func(cmp int) bool { return cmp <= 0 }, nil
+	case ">":
+		return func(cmp int) bool { return cmp > 0 }, nil
+	case ">=":
+		return func(cmp int) bool { return cmp >= 0 }, nil
+	}
+	return nil, fmt.Errorf("invalid operator %q", operator)
+}
+
+func allowedKey(value string, ignoreCase bool) string {
+	value = strings.TrimSpace(value)
+	if ignoreCase {
+		return strings.ToLower(value)
+	}
+	return value
+}
@@ -0,0 +1,108 @@
+package cleaner
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func ruleTable() *models.DataTable {
+	dt := models.NewDataTable([]string{"ID", "Age", "Email", "Status", "Start", "End"})
+	dt.AddRow([]string{"1", "30", "a@b.com", "active", "2024-01-01", "2024-02-01"})
+	dt.AddRow([]string{"2", "-5", "not-an-email", "closed", "2024-03-01", "2024-02-01"})
+	dt.AddRow([]string{"2", "abc", "", "ACTV", "01.04.2024", "2024-05-01"})
+	dt.Nulls = models.DefaultNullMarkers()
+	return dt
+}
+
+func TestValidateRules(t *testing.T) {
+	zero, hundred := 0.0, 100.0
+	three := 3
+	rules := []Rule{
+		{Column: "ID", Type: RuleUnique},
+		{Column: "Age", Type: RuleMin, Min: &zero},
+		{Column: "Age", Type: RuleMax, Max: &hundred},
+		{Column: "Email", Type: RuleRequired},
+		{Column: "Email", Type: RuleDataType, DataType: DataEmail},
+		{Column: "Status", Type: RuleAllowed, Values: []string{"active", "closed"}, Name: "status values"},
+		{Column: "Status", Type: RuleLength, MaxLength: &three},
+		{Column: "End", Type: RuleCompare, Operator: ">=", Other: "Start"},
+		{Column: "Start", Type: RuleDateRange, After: "2024-01-15"},
+		{Type: RuleExpression, Expression: "len(Status) > 4"},
+	}
+
+	violations, err := ValidateRules(ruleTable(), rules)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	type key struct {
+		row  int
+		rule string
+	}
+	got := make(map[key]bool)
+	for _, v := range violations {
+		got[key{v.Row, v.Rule}] = true
+	}
+
+	expected := []key{
+		{2, "ID unique"},
+		{1, "Age min"},
+		{2, "Age min"}, // not a number
+		{2, "Age max"},
+		{2, "Email required"},
+		{1, "Email type"},
+		{2, "status values"},
+		{0, "Status length"},
+		{1, "Status length"},
+		{2, "Status length"},
+		{1, "End compare"},
+		{0, "Start date_range"},
+		{2, "expression"},
+	}
+	for _, k := range expected {
+		if !got[k] {
+			t.Errorf("Expected violation of %q in row %d", k.rule, k.row)
+		}
+	}
+	if len(violations) != len(expected) {
+		t.Errorf("Expected %d violations, got %d: %v", len(expected), len(violations), violations)
+	}
+}
+
+func TestValidateRulesInvalid(t *testing.T) {
+	dt := ruleTable()
+	invalid := []Rule{
+		{Column: "Missing", Type: RuleRequired},
+		{Column: "Age", Type: RuleMin},
+		{Column: "Age", Type: RuleDataType, DataType: "money"},
+		{Column: "Email", Type: RulePattern, Pattern: "("},
+		{Column: "End", Type: RuleCompare, Operator: "~", Other: "Start"},
+		{Column: "Age", Type: "between"},
+	}
+
+	for _, rule := range invalid {
+		if _, err := ValidateRules(dt, []Rule{rule}); err == nil {
+			t.Errorf("Expected error for rule %+v", rule)
+		}
+	}
+}
+
+func TestRuleSetSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	minAge := 18.0
+	rs := RuleSet{Rules: []Rule{{Column: "Age", Type: RuleMin, Min: &minAge}}}
+
+	if err := rs.Save(path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	loaded, err := LoadRules(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(loaded.Rules) != 1 || *loaded.Rules[0].Min != 18 {
+		t.Errorf("Unexpected rules after round trip: %+v", loaded.Rules)
+	}
+}
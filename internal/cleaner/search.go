@@ -0,0 +1,83 @@
+package cleaner
+
+import (
+	"context"
+	"fmt"
+	"unicode"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// SearchOptions configures a cell search
+type SearchOptions struct {
+	Query   string // Text or regular expression to search for
+	Columns []int  // Column indexes to search (empty means all columns), so duplicate headers stay apart
+	Regex   bool   // Treat Query as a regular expression
+}
+
+// searchCheckEvery is the number of rows searched between cancellation checks
+const searchCheckEvery = 1000
+
+// FindCells returns the cells containing the query, row by row
+// rows limits and orders the search (nil searches every row in table order)
+// Matching ignores case unless the query has an upper case letter
+// The search stops with the context's error when it is cancelled
+func FindCells(ctx context.Context, dt *models.DataTable, opts SearchOptions, rows []int) ([]models.CellRef, error) {
+	if dt == nil {
+		return nil, fmt.Errorf("no data to search")
+	}
+
+	re, err := compileReplace(ReplaceOptions{Find: opts.Query, Regex: opts.Regex, IgnoreCase: !hasUpper(opts.Query)})
+	if err != nil {
+		return nil, err
+	}
+	columns := opts.Columns
+	if len(columns) == 0 {
+		columns = make([]int, dt.ColumnCount())
+		for i := range columns {
+			columns[i] = i
+		}
+	}
+	for _, colIdx := range columns {
+		if colIdx < 0 || colIdx >= dt.ColumnCount() {
+			return nil, fmt.Errorf("column %d out of range (1-%d)", colIdx+1, dt.ColumnCount())
+		}
+	}
+
+	count := len(dt.Rows)
+	if rows != nil {
+		count = len(rows)
+	}
+
+	var matches []models.CellRef
+	for i := 0; i < count; i++ {
+		if i%searchCheckEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		rowIdx := i
+		if rows != nil {
+			rowIdx = rows[i]
+		}
+		row := dt.Rows[rowIdx]
+		for _, colIdx := range columns {
+			if colIdx < len(row) && re.MatchString(row[colIdx]) {
+				matches = append(matches, models.CellRef{Row: rowIdx, Col: colIdx})
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+// hasUpper reports whether s contains an upper case letter
+func hasUpper(s string) bool {
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func TestFindCells(t *testing.T) {
+	dt := models.NewDataTable([]string{"name", "city"})
+	dt.AddRow([]string{"Ankara Ltd", "Ankara"})
+	dt.AddRow([]string{"Izmir AŞ", "izmir"})
+	dt.AddRow([]string{"Bursa", "ANKARA"})
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		opts SearchOptions
+		rows []int
+		want []models.CellRef
+	}{
+		{"lower case ignores case", SearchOptions{Query: "ankara"}, nil,
+			[]models.CellRef{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 2, Col: 1}}},
+		{"upper case matches case", SearchOptions{Query: "Ankara"}, nil,
+			[]models.CellRef{{Row: 0, Col: 0}, {Row: 0, Col: 1}}},
+		{"one column", SearchOptions{Query: "izmir", Columns: []int{1}}, nil,
+			[]models.CellRef{{Row: 1, Col: 1}}},
+		{"rows in view order", SearchOptions{Query: "ankara", Columns: []int{1}}, []int{2, 0},
+			[]models.CellRef{{Row: 2, Col: 1}, {Row: 0, Col: 1}}},
+		{"regex", SearchOptions{Query: "^b", Regex: true}, nil,
+			[]models.CellRef{{Row: 2, Col: 0}}},
+	}
+
+	for _, tt := range tests {
+		got, err := FindCells(ctx, dt, tt.opts, tt.rows)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+
+	if _, err := FindCells(ctx, dt, SearchOptions{Query: ""}, nil); err == nil {
+		t.Error("Expected error for an empty query")
+	}
+	if _, err := FindCells(ctx, dt, SearchOptions{Query: "a", Columns: []int{2}}, nil); err == nil {
+		t.Error("Expected error for a column out of range")
+	}
+
+	// Columns sharing a header are searched separately
+	dup := models.NewDataTable([]string{"city", "city"})
+	dup.AddRow([]string{"Bursa", "Ankara"})
+	got, err := FindCells(ctx, dup, SearchOptions{Query: "ankara", Columns: []int{0, 1}}, nil)
+	if err != nil || !reflect.DeepEqual(got, []models.CellRef{{Row: 0, Col: 1}}) {
+		t.Errorf("Expected the second city column searched, got %v (%v)", got, err)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := FindCells(cancelled, dt, SearchOptions{Query: "a"}, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,269 @@
+package cleaner
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// Collation defines how the values of a sort column are compared
+type Collation string
+
+const (
+	CollateAuto    Collation = ""        // Numeric or date when every value parses, otherwise natural
+	CollateText    Collation = "text"    // Byte-wise string comparison
+	CollateNumeric Collation = "numeric" // Numbers; values that are not numbers sort after them as text
+	CollateDate    Collation = "date"    // Dates in any detected format; other values sort after them
+	CollateNatural Collation = "natural" // Case-insensitive, digit runs compared as numbers (item2 < item10)
+	CollateTurkish Collation = "turkish" // Turkish alphabet order (c < ç < d, ı < i)
+)
+
+// Collations lists the available collations
+var Collations = []Collation{CollateText, CollateNumeric, CollateDate, CollateNatural, CollateTurkish}
+
+// SortKey is one column of a multi-column sort
+type SortKey struct {
+	Column     string    `json:"column"`
+	Descending bool      `json:"descending,omitempty"`
+	Collation  Collation `json:"collation,omitempty"`
+}
+
+// String formats the key as "column [desc] [collation]"
+func (k SortKey) String() string {
+	s := k.Column
+	if k.Descending {
+		s += " desc"
+	}
+	if k.Collation != CollateAuto {
+		s += " " + string(k.Collation)
+	}
+	return s
+}
+
+// sortValue is a cell prepared for comparison
+type sortValue struct {
+	missing bool
+	parsed  bool // false when a numeric or date value did not parse
+	number  float64
+	text    string
+}
+
+// SortOrder returns row indexes in sorted order; the sort is stable
+// rows limits the sort to a subset of rows (nil sorts every row)
+// Missing values always come last, in either direction
+func SortOrder(dt *models.DataTable, keys []SortKey, rows []int) ([]int, error) {
+	if dt == nil {
+		return nil, fmt.Errorf("no data to sort")
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no sort columns given")
+	}
+
+	if rows == nil {
+		rows = make([]int, len(dt.Rows))
+		for i := range rows {
+			rows[i] = i
+		}
+	}
+
+	// Prepare every key column once instead of parsing on each comparison
+	values := make([][]sortValue, len(keys))
+	compares := make([]func(a, b sortValue) int, len(keys))
+	for k, key := range keys {
+		colIdx := dt.ColumnIndex(key.Column)
+		if colIdx == -1 {
+			return nil, fmt.Errorf("column %q not found", key.Column)
+		}
+		var err error
+		values[k], compares[k], err = prepareSortColumn(dt, colIdx, key.Collation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	order := make([]int, len(rows))
+	copy(order, rows)
+	sort.SliceStable(order, func(i, j int) bool {
+		for k, key := range keys {
+			a, b := values[k][order[i]], values[k][order[j]]
+			if a.missing || b.missing {
+				if a.missing != b.missing {
+					return b.missing
+				}
+				continue
+			}
+			c := compares[k](a, b)
+			if key.Descending {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+
+	return order, nil
+}
+
+// SortRows returns a copy of the table with its rows sorted by the keys
+func SortRows(dt *models.DataTable, keys []SortKey) (*models.DataTable, error) {
+	order, err := SortOrder(dt, keys, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := dt.Clone()
+	for i, rowIdx := range order {
+		result.Rows[i] = append([]string(nil), dt.Rows[rowIdx]...)
+	}
+
+	return result, nil
+}
+
+// prepareSortColumn parses the cells of a column for a collation
+func prepareSortColumn(dt *models.DataTable, colIdx int, collation Collation) ([]sortValue, func(a, b sortValue) int, error) {
+	cells, _ := dt.GetColumn(colIdx)
+
+	if collation == CollateAuto {
+		collation = detectCollation(dt, colIdx, cells)
+	}
+
+	values := make([]sortValue, len(cells))
+	for i, cell := range cells {
+		values[i] = sortValue{missing: dt.IsMissing(colIdx, cell), text: cell}
+	}
+
+	switch collation {
+	case CollateText:
+		return values, func(a, b sortValue) int { return strings.Compare(a.text, b.text) }, nil
+
+	case CollateNumeric:
+		for i := range values {
+			values[i].number, values[i].parsed = parseNumber(values[i].text)
+		}
+		return values, compareParsed, nil
+
+	case CollateDate:
+		_, dayFirst := detectDateFormats(dt, colIdx, cells, true, false)
+		for i := range values {
+			if t, _, ok := parseDate(values[i].text, dayFirst); ok {
+				values[i].number, values[i].parsed = float64(t.Unix())+float64(t.Nanosecond())/float64(time.Second), true
+			}
+		}
+		return values, compareParsed, nil
+
+	case CollateNatural:
+		return values, func(a, b sortValue) int { return naturalCompare(a.text, b.text) }, nil
+
+	case CollateTurkish:
+		c := collate.New(language.Turkish, collate.IgnoreCase)
+		return values, func(a, b sortValue) int { return c.CompareString(a.text, b.text) }, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown collation %q", collation)
+	}
+}
+
+// detectCollation picks numeric or date when every non-missing value parses as one
+func detectCollation(dt *models.DataTable, colIdx int, cells []string) Collation {
+	numeric, dates, seen := true, true, false
+	_, dayFirst := detectDateFormats(dt, colIdx, cells, true, false)
+	for _, cell := range cells {
+		if dt.IsMissing(colIdx, cell) {
+			continue
+		}
+		seen = true
+		if numeric {
+			_, numeric = parseNumber(cell)
+		}
+		if dates {
+			_, _, dates = parseDate(cell, dayFirst)
+		}
+		if !numeric && !dates {
+			break
+		}
+	}
+
+	switch {
+	case seen && numeric:
+		return CollateNumeric
+	case seen && dates:
+		return CollateDate
+	}
+	return CollateNatural
+}
+
+// compareParsed orders parsed values by number, unparsed ones after them as text
+func compareParsed(a, b sortValue) int {
+	switch {
+	case a.parsed && b.parsed:
+		switch {
+		case a.number < b.number:
+			return -1
+		case a.number > b.number:
+			return 1
+		}
+		return 0
+	case a.parsed != b.parsed:
+		if a.parsed {
+			return -1
+		}
+		return 1
+	}
+	return naturalCompare(a.text, b.text)
+}
+
+// naturalCompare compares case-insensitively, treating runs of digits as numbers
+func naturalCompare(a, b string) int {
+	ra, rb := []rune(strings.ToLower(a)), []rune(strings.ToLower(b))
+	i, j := 0, 0
+	for i < len(ra) && j < len(rb) {
+		if unicode.IsDigit(ra[i]) && unicode.IsDigit(rb[j]) {
+			si, sj := i, j
+			for i < len(ra) && unicode.IsDigit(ra[i]) {
+				i++
+			}
+			for j < len(rb) && unicode.IsDigit(rb[j]) {
+				j++
+			}
+			// Compare digit runs by length without leading zeros, then digit by digit
+			na := strings.TrimLeft(string(ra[si:i]), "0")
+			nb := strings.TrimLeft(string(rb[sj:j]), "0")
+			if len(na) != len(nb) {
+				if len(na) < len(nb) {
+					return -1
+				}
+				return 1
+			}
+			if c := strings.Compare(na, nb); c != 0 {
+				return c
+			}
+			continue
+		}
+
+		if ra[i] != rb[j] {
+			if ra[i] < rb[j] {
+				return -1
+			}
+			return 1
+		}
+		i++
+		j++
+	}
+
+	switch {
+	case len(ra)-i < len(rb)-j:
+		return -1
+	case len(ra)-i > len(rb)-j:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,86 @@
+package cleaner
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func sortColumn(t *testing.T, values []string, key SortKey) []string {
+	t.Helper()
+	dt := models.NewDataTable([]string{key.Column})
+	for _, v := range values {
+		dt.AddRow([]string{v})
+	}
+
+	sorted, err := SortRows(dt, []SortKey{key})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got := make([]string, len(sorted.Rows))
+	for i, row := range sorted.Rows {
+		got[i] = row[0]
+	}
+	return got
+}
+
+func TestSortCollations(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		key    SortKey
+		want   []string
+	}{
+		{"auto numeric", []string{"10", "9", "", "-1.5"}, SortKey{Column: "n"},
+			[]string{"-1.5", "9", "10", ""}},
+		{"numeric descending keeps missing last", []string{"10", "", "9", "100"}, SortKey{Column: "n", Descending: true},
+			[]string{"100", "10", "9", ""}},
+		{"auto date", []string{"15.03.2024", "02.01.2024", "28.02.2023"}, SortKey{Column: "d"},
+			[]string{"28.02.2023", "02.01.2024", "15.03.2024"}},
+		{"natural", []string{"item10", "Item2", "item1"}, SortKey{Column: "s", Collation: CollateNatural},
+			[]string{"item1", "Item2", "item10"}},
+		{"text", []string{"item10", "Item2", "item1"}, SortKey{Column: "s", Collation: CollateText},
+			[]string{"Item2", "item1", "item10"}},
+		{"turkish", []string{"Zeynep", "Çiğdem", "Ceren", "Işık", "İpek", "Dilek"}, SortKey{Column: "s", Collation: CollateTurkish},
+			[]string{"Ceren", "Çiğdem", "Dilek", "Işık", "İpek", "Zeynep"}},
+		{"numeric with text after numbers", []string{"n/a", "5", "3"}, SortKey{Column: "n", Collation: CollateNumeric},
+			[]string{"3", "5", "n/a"}},
+	}
+
+	for _, tt := range tests {
+		if got := sortColumn(t, tt.values, tt.key); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestSortMultipleKeysIsStable(t *testing.T) {
+	dt := models.NewDataTable([]string{"city", "price", "id"})
+	dt.AddRow([]string{"Izmir", "10", "1"})
+	dt.AddRow([]string{"Ankara", "5", "2"})
+	dt.AddRow([]string{"Izmir", "20", "3"})
+	dt.AddRow([]string{"Ankara", "5", "4"})
+
+	order, err := SortOrder(dt, []SortKey{{Column: "city"}, {Column: "price", Descending: true}}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := []int{1, 3, 2, 0}; !reflect.DeepEqual(order, want) {
+		t.Errorf("Expected order %v, got %v", want, order)
+	}
+
+	// Sorting a subset only reorders those rows
+	order, _ = SortOrder(dt, []SortKey{{Column: "price"}}, []int{2, 0})
+	if want := []int{0, 2}; !reflect.DeepEqual(order, want) {
+		t.Errorf("Expected subset order %v, got %v", want, order)
+	}
+
+	if _, err := SortOrder(dt, []SortKey{{Column: "missing"}}, nil); err == nil {
+		t.Error("Expected error for unknown column")
+	}
+	if _, err := SortOrder(dt, []SortKey{{Column: "city", Collation: "roman"}}, nil); err == nil {
+		t.Error("Expected error for unknown collation")
+	}
+}
@@ -0,0 +1,125 @@
+package cleaner
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// TextCase defines a case conversion applied to cells
+type TextCase string
+
+const (
+	CaseNone  TextCase = ""
+	CaseUpper TextCase = "upper"
+	CaseLower TextCase = "lower"
+	CaseTitle TextCase = "title"
+)
+
+// TextCases lists the available case conversions
+var TextCases = []TextCase{CaseUpper, CaseLower, CaseTitle}
+
+// TextOptions configures text standardization
+type TextOptions struct {
+	Columns          []string `json:"columns,omitempty"`           // Columns to standardize (empty means all)
+	CollapseSpaces   bool     `json:"collapse_spaces,omitempty"`   // Collapse internal runs of spaces and trim the ends
+	RemoveInvisible  bool     `json:"remove_invisible,omitempty"`  // Replace non-breaking spaces, drop zero-width and control characters
+	NormalizeUnicode bool     `json:"normalize_unicode,omitempty"` // Unicode NFC normalization
+	Case             TextCase `json:"case,omitempty"`              // Case conversion
+	Turkish          bool     `json:"turkish,omitempty"`           // Use Turkish casing rules (i/İ, ı/I)
+}
+
+// StandardizeText cleans up whitespace, invisible characters, Unicode form and case
+// Returns the new table and the number of cells changed
+func StandardizeText(dt *models.DataTable, opts TextOptions) (*models.DataTable, int, error) {
+	if dt == nil {
+		return nil, 0, fmt.Errorf("no data to standardize")
+	}
+
+	convert, err := caseConverter(opts.Case, opts.Turkish)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	cols, err := resolveColumns(dt, opts.Columns)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := dt.Clone()
+	changed := 0
+	for i, row := range result.Rows {
+		for _, colIdx := range cols {
+			if colIdx >= len(row) {
+				continue
+			}
+
+			value := row[colIdx]
+			if opts.RemoveInvisible {
+				value = removeInvisible(value)
+			}
+			if opts.NormalizeUnicode {
+				value = norm.NFC.String(value)
+			}
+			if opts.CollapseSpaces {
+				value = strings.Join(strings.Fields(value), " ")
+			}
+			if convert != nil && !dt.IsMissing(colIdx, value) {
+				value = convert(value)
+			}
+
+			if value != row[colIdx] {
+				result.Rows[i][colIdx] = value
+				changed++
+			}
+		}
+	}
+
+	return result, changed, nil
+}
+
+// caseConverter returns the conversion for a case, nil for CaseNone
+func caseConverter(c TextCase, turkish bool) (func(string) string, error) {
+	tag := language.Und
+	if turkish {
+		tag = language.Turkish
+	}
+
+	var caser cases.Caser
+	switch c {
+	case CaseNone:
+		return nil, nil
+	case CaseUpper:
+		caser = cases.Upper(tag)
+	case CaseLower:
+		caser = cases.Lower(tag)
+	case CaseTitle:
+		caser = cases.Title(tag)
+	default:
+		return nil, fmt.Errorf("unknown case %q", c)
+	}
+
+	return caser.String, nil
+}
+
+// removeInvisible turns unusual spaces into plain spaces and drops
+// zero-width and control characters
+func removeInvisible(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\u200b', r == '\u200c', r == '\u200d', r == '\u2060', r == '\ufeff':
+			return -1 // zero-width space/joiners, word joiner, byte order mark
+		case unicode.IsSpace(r):
+			return ' ' // tabs, newlines, non-breaking and other Unicode spaces
+		case unicode.IsControl(r):
+			return -1
+		}
+		return r
+	}, s)
+}
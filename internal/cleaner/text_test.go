@@ -0,0 +1,80 @@
+package cleaner
+
+import (
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func TestStandardizeTextWhitespace(t *testing.T) {
+	dt := models.NewDataTable([]string{"Name"})
+	dt.AddRow([]string{"  Ali    Veli\t"})
+	dt.AddRow([]string{"Ay\u200bşe\u0007"})
+	dt.AddRow([]string{"ok"})
+
+	got, changed, err := StandardizeText(dt, TextOptions{RemoveInvisible: true, CollapseSpaces: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if changed != 2 {
+		t.Errorf("Expected 2 cells changed, got %d", changed)
+	}
+	if got.Rows[0][0] != "Ali Veli" {
+		t.Errorf("Expected 'Ali Veli', got %q", got.Rows[0][0])
+	}
+	if got.Rows[1][0] != "Ayşe" {
+		t.Errorf("Expected 'Ayşe', got %q", got.Rows[1][0])
+	}
+}
+
+func TestStandardizeTextNFC(t *testing.T) {
+	dt := models.NewDataTable([]string{"City"})
+	dt.AddRow([]string{"Mug\u0306la"}) // g + combining breve
+
+	got, _, err := StandardizeText(dt, TextOptions{NormalizeUnicode: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got.Rows[0][0] != "Muğla" {
+		t.Errorf("Expected composed 'Muğla', got %q", got.Rows[0][0])
+	}
+}
+
+func TestStandardizeTextTurkishCase(t *testing.T) {
+	dt := models.NewDataTable([]string{"City", "Code"})
+	dt.AddRow([]string{"istanbul ılıca", "ab"})
+	dt.AddRow([]string{"N/A", "cd"})
+	dt.Nulls = models.DefaultNullMarkers()
+
+	tests := []struct {
+		textCase TextCase
+		turkish  bool
+		expected string
+	}{
+		{CaseUpper, true, "İSTANBUL ILICA"},
+		{CaseTitle, true, "İstanbul Ilıca"},
+		{CaseUpper, false, "ISTANBUL ILICA"},
+	}
+
+	for _, tt := range tests {
+		got, _, err := StandardizeText(dt, TextOptions{Columns: []string{"City"}, Case: tt.textCase, Turkish: tt.turkish})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.Rows[0][0] != tt.expected {
+			t.Errorf("%s (turkish=%v): expected %q, got %q", tt.textCase, tt.turkish, tt.expected, got.Rows[0][0])
+		}
+		if got.Rows[1][0] != "N/A" {
+			t.Errorf("Expected null marker untouched, got %q", got.Rows[1][0])
+		}
+		if got.Rows[0][1] != "ab" {
+			t.Errorf("Expected column outside scope unchanged, got %q", got.Rows[0][1])
+		}
+	}
+
+	if _, _, err := StandardizeText(dt, TextOptions{Case: "camel"}); err == nil {
+		t.Error("Expected error for unknown case")
+	}
+}
@@ -1,6 +1,7 @@
 package cleaner
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/veliulugut/snapclean/internal/models"
@@ -8,12 +9,15 @@ import (
 
 // ValidationResult contains data quality metrics
 type ValidationResult struct {
-	HasDuplicates     bool
-	DuplicateCount    int
-	MissingValueCount int
-	EmptyRowCount     int
-	EmptyColumnCount  int
-	TotalIssues       int
+	HasDuplicates     bool            `json:"has_duplicates"`
+	DuplicateCount    int             `json:"duplicate_count"`
+	MissingValueCount int             `json:"missing_value_count"`
+	EmptyRowCount     int             `json:"empty_row_count"`
+	EmptyColumnCount  int             `json:"empty_column_count"`
+	OutlierCount      int             `json:"outlier_count"`      // Reported apart from TotalIssues
+	Outliers          []OutlierReport `json:"outliers,omitempty"` // Numeric columns with IQR outliers
+	TotalIssues       int             `json:"total_issues"`
+	Issues            []Issue         `json:"-"` // Every problem with its location, sorted by position (reports write them with the rule issues)
 }
 
 // ValidateData performs comprehensive data validation
@@ -25,48 +29,102 @@ func ValidateData(dt *models.DataTable) ValidationResult {
 	}
 
 	// Count duplicates
-	seen := make(map[string]bool)
-	for _, row := range dt.Rows {
+	seen := make(map[string]int)
+	for i, row := range dt.Rows {
 		key := strings.Join(row, "|||")
-		if seen[key] {
+		if first, exists := seen[key]; exists {
 			result.DuplicateCount++
+			result.Issues = append(result.Issues, Issue{
+				Row:      i,
+				Kind:     IssueDuplicate,
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("duplicate of row %d", first+1),
+			})
+			continue
 		}
-		seen[key] = true
+		seen[key] = i
 	}
 	result.HasDuplicates = result.DuplicateCount > 0
 
 	// Count empty rows
-	for _, row := range dt.Rows {
-		if isRowEmpty(row) {
+	emptyRows := make(map[int]bool)
+	for i, row := range dt.Rows {
+		if isRowEmpty(dt, row) {
 			result.EmptyRowCount++
+			emptyRows[i] = true
+			result.Issues = append(result.Issues, Issue{
+				Row:      i,
+				Kind:     IssueEmptyRow,
+				Severity: SeverityWarning,
+				Message:  "row is empty",
+			})
 		}
 	}
 
 	// Count empty columns
+	emptyColumns := make(map[int]bool)
 	for colIdx := 0; colIdx < dt.ColumnCount(); colIdx++ {
 		column, _ := dt.GetColumn(colIdx)
 		isEmpty := true
 		for _, cell := range column {
-			if strings.TrimSpace(cell) != "" {
+			if !dt.IsMissing(colIdx, cell) {
 				isEmpty = false
 				break
 			}
 		}
 		if isEmpty {
 			result.EmptyColumnCount++
+			emptyColumns[colIdx] = true
+			result.Issues = append(result.Issues, Issue{
+				Row:      -1,
+				Column:   dt.Headers[colIdx],
+				Kind:     IssueEmptyColumn,
+				Severity: SeverityWarning,
+				Message:  "column is empty",
+			})
 		}
 	}
 
-	// Count missing values
-	for _, row := range dt.Rows {
-		for _, cell := range row {
-			if strings.TrimSpace(cell) == "" {
-				result.MissingValueCount++
+	// Count missing values (cells of empty rows and columns are reported once, above)
+	for i, row := range dt.Rows {
+		for colIdx, cell := range row {
+			if !dt.IsMissing(colIdx, cell) {
+				continue
 			}
+			result.MissingValueCount++
+			if !emptyRows[i] && !emptyColumns[colIdx] && colIdx < len(dt.Headers) {
+				result.Issues = append(result.Issues, Issue{
+					Row:      i,
+					Column:   dt.Headers[colIdx],
+					Kind:     IssueMissing,
+					Severity: SeverityInfo,
+					Message:  "value is missing",
+				})
+			}
+		}
+	}
+
+	// Find outliers in numeric columns
+	for _, header := range NumericColumns(dt) {
+		report, err := DetectOutliers(dt, OutlierOptions{Column: header, Method: OutlierIQR})
+		if err != nil || len(report.Outliers) == 0 {
+			continue
+		}
+		result.Outliers = append(result.Outliers, report)
+		result.OutlierCount += len(report.Outliers)
+		for _, o := range report.Outliers {
+			result.Issues = append(result.Issues, Issue{
+				Row:      o.Row,
+				Column:   header,
+				Kind:     IssueOutlier,
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("outside %.4g .. %.4g", report.Lower, report.Upper),
+			})
 		}
 	}
+	SortIssues(dt, result.Issues)
 
-	// Calculate total issues
+	// Calculate total issues (outliers are suspicious values, not errors, and are counted apart)
 	result.TotalIssues = result.DuplicateCount + result.EmptyRowCount +
 		result.EmptyColumnCount + result.MissingValueCount
 
@@ -110,7 +168,7 @@ func GetMissingValuesByColumn(dt *models.DataTable) map[string]int {
 		count := 0
 
 		for _, row := range dt.Rows {
-			if colIdx >= len(row) || strings.TrimSpace(row[colIdx]) == "" {
+			if colIdx >= len(row) || dt.IsMissing(colIdx, row[colIdx]) {
 				count++
 			}
 		}
@@ -96,3 +96,74 @@ func TestGetMissingValuesByColumnNil(t *testing.T) {
 		t.Errorf("Expected empty map for nil, got %v", result)
 	}
 }
+
+func TestGetMissingValuesByColumnWithNullMarkers(t *testing.T) {
+	dt := models.NewDataTable([]string{"Name", "Age"})
+	dt.Nulls = models.DefaultNullMarkers()
+	dt.AddRow([]string{"John", "N/A"})
+	dt.AddRow([]string{"?", "30"})
+
+	result := GetMissingValuesByColumn(dt)
+
+	if result["Name"] != 1 || result["Age"] != 1 {
+		t.Errorf("Expected 1 missing per column, got %v", result)
+	}
+
+	if got := ValidateData(dt).MissingValueCount; got != 2 {
+		t.Errorf("Expected 2 missing values, got %d", got)
+	}
+}
+
+func TestValidateDataIssues(t *testing.T) {
+	dt := models.NewDataTable([]string{"Name", "Age", "Note"})
+	dt.AddRow([]string{"John", "30", ""})
+	dt.AddRow([]string{"John", "30", ""}) // Duplicate
+	dt.AddRow([]string{"", "", ""})       // Empty
+	dt.AddRow([]string{"Jane", "", ""})   // Missing age
+
+	result := ValidateData(dt)
+
+	expected := []Issue{
+		{Row: -1, Column: "Note", Kind: IssueEmptyColumn},
+		{Row: 1, Kind: IssueDuplicate},
+		{Row: 2, Kind: IssueEmptyRow},
+		{Row: 3, Column: "Age", Kind: IssueMissing},
+	}
+	if len(result.Issues) != len(expected) {
+		t.Fatalf("Expected %d issues, got %v", len(expected), result.Issues)
+	}
+	for i, want := range expected {
+		got := result.Issues[i]
+		if got.Row != want.Row || got.Column != want.Column || got.Kind != want.Kind {
+			t.Errorf("Issue %d: expected %+v, got %+v", i, want, got)
+		}
+	}
+
+	if cell, ok := result.Issues[3].Cell(dt); !ok || cell != (models.CellRef{Row: 3, Col: 1}) {
+		t.Errorf("Expected cell (3, 1), got %v %v", cell, ok)
+	}
+	if _, ok := result.Issues[1].Cell(dt); ok {
+		t.Error("Expected row-level issue to have no cell")
+	}
+}
+
+func TestPageIssues(t *testing.T) {
+	issues := make([]Issue, 7)
+	for i := range issues {
+		issues[i].Row = i
+	}
+
+	page, pages := PageIssues(issues, 1, 3)
+	if pages != 3 || len(page) != 3 || page[0].Row != 3 {
+		t.Errorf("Unexpected page 1: %v of %d", page, pages)
+	}
+
+	page, _ = PageIssues(issues, 10, 3)
+	if len(page) != 1 || page[0].Row != 6 {
+		t.Errorf("Expected last page to be clamped, got %v", page)
+	}
+
+	if page, pages := PageIssues(nil, 0, 3); page != nil || pages != 0 {
+		t.Errorf("Expected no pages for no issues, got %v %d", page, pages)
+	}
+}
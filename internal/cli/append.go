@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/file"
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/reshaper"
+)
+
+const appendUsage = "append [-normalize] [-source column] [-o file] <file or glob>..."
+
+// runAppend stacks several files into one table and writes the result
+func runAppend(args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("append", appendUsage, stderr)
+	normalize := fs.Bool("normalize", false, "match headers ignoring case, spaces, punctuation and Turkish letters")
+	source := fs.String("source", "", "add a column with this name holding the source file name")
+	output := fs.String("o", "", "output file (.csv or .xlsx); CSV on stdout when empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("expected at least one input file")
+	}
+
+	// Patterns are usually expanded by the shell already; ExpandFiles handles quoted ones
+	result, err := reshaper.AppendFiles(nil, fs.Args(), reshaper.AppendOptions{
+		MatchNormalized: *normalize,
+		SourceColumn:    strings.TrimSpace(*source),
+	})
+	if err != nil {
+		return err
+	}
+	result.Table.Nulls = models.DefaultNullMarkers()
+
+	// The summary goes to stderr so stdout stays valid CSV
+	summary := stderr
+	if *output != "" {
+		if err := file.SaveFile(result.Table, *output); err != nil {
+			return err
+		}
+		summary = stdout
+		fmt.Fprintf(summary, "Appended table written to %s (%d rows)\n", *output, result.Table.RowCount())
+	} else if err := file.WriteCSV(stdout, result.Table); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(summary, "%d files combined, %d with different headers\n", len(result.Files), len(result.Mismatches))
+	for _, mm := range result.Mismatches {
+		fmt.Fprintf(summary, "  %s:", mm.File)
+		if len(mm.Missing) > 0 {
+			fmt.Fprintf(summary, " missing %s", strings.Join(mm.Missing, ", "))
+		}
+		if len(mm.Extra) > 0 {
+			fmt.Fprintf(summary, " extra %s", strings.Join(mm.Extra, ", "))
+		}
+		fmt.Fprintln(summary)
+	}
+	return nil
+}
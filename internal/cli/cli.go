@@ -0,0 +1,89 @@
+// Package cli implements the command line interface used when snapclean is
+// started with arguments. Without arguments the TUI is started instead.
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/file"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// command is a CLI subcommand
+type command struct {
+	usage string
+	run   func(args []string, stdout, stderr io.Writer) error
+}
+
+// commands maps subcommand names to their implementation
+var commands = map[string]command{
+	"append": {appendUsage, runAppend},
+	"join":   {joinUsage, runJoin},
+	"report": {reportUsage, runReport},
+	"split":  {splitUsage, runSplit},
+}
+
+// Run executes a command line invocation; args exclude the program name
+func Run(args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(stdout)
+		return nil
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		printUsage(stderr)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+	return cmd.run(args[1:], stdout, stderr)
+}
+
+func printUsage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "Usage: snapclean [command] [flags]")
+	fmt.Fprintln(w, "Run without a command to start the interactive interface.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, name := range names {
+		fmt.Fprintf(w, "  snapclean %s\n", commands[name].usage)
+	}
+}
+
+// newFlagSet creates a flag set for a subcommand that reports errors instead of exiting
+func newFlagSet(name, usage string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: snapclean %s\n", usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// loadTable loads an input file the same way the TUI does
+func loadTable(path string) (*models.DataTable, error) {
+	dt, err := file.LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	dt.Nulls = models.DefaultNullMarkers()
+	return dt, nil
+}
+
+// inputArg returns the single positional argument of a subcommand
+func inputArg(fs *flag.FlagSet) (string, error) {
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return "", fmt.Errorf("expected one input file, got %d arguments", fs.NArg())
+	}
+	return strings.TrimSpace(fs.Arg(0)), nil
+}
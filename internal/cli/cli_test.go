@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeCSV(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestRunUnknownCommand(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if err := Run([]string{"explode"}, &stdout, &stderr); err == nil {
+		t.Error("Expected error for unknown command")
+	}
+	if !strings.Contains(stderr.String(), "Usage") {
+		t.Error("Expected usage on stderr")
+	}
+}
+
+func TestRunReport(t *testing.T) {
+	dir := t.TempDir()
+	input := writeCSV(t, dir, "people.csv", "Name,Age\nJohn,30\nJane,\n")
+
+	var stdout, stderr bytes.Buffer
+	if err := Run([]string{"report", input}, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v (%s)", err, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "# Data Quality Report") {
+		t.Errorf("Expected Markdown report on stdout, got:\n%s", stdout.String())
+	}
+
+	output := filepath.Join(dir, "report.html")
+	stdout.Reset()
+	if err := Run([]string{"report", "-o", output, input}, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if data, err := os.ReadFile(output); err != nil || !strings.Contains(string(data), "<html") {
+		t.Errorf("Expected HTML report file, got error %v", err)
+	}
+
+	if err := Run([]string{"report", "-o", output, "-format", "json", input}, &stdout, &stderr); err == nil {
+		t.Error("Expected error for mismatched format and extension")
+	}
+}
+
+func TestRunJoin(t *testing.T) {
+	dir := t.TempDir()
+	orders := writeCSV(t, dir, "orders.csv", "order,customer\nA1,1\nA2,2\nA3,9\n")
+	customers := writeCSV(t, dir, "customers.csv", "id,name\n1,Ali\n2,Ayşe\n3,Can\n")
+
+	var stdout, stderr bytes.Buffer
+	if err := Run([]string{"join", "-on", "customer=id", orders, customers}, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v (%s)", err, stderr.String())
+	}
+
+	want := "order,customer,name\nA1,1,Ali\nA2,2,Ayşe\nA3,9,\n"
+	if stdout.String() != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "Unmatched left keys (1): 9") || !strings.Contains(stderr.String(), "Unmatched right keys (1): 3") {
+		t.Errorf("Expected unmatched keys report, got:\n%s", stderr.String())
+	}
+
+	output := filepath.Join(dir, "joined.xlsx")
+	stdout.Reset()
+	if err := Run([]string{"join", "-type", "inner", "-on", "customer=id", "-o", output, orders, customers}, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "(2 rows)") {
+		t.Errorf("Expected 2 joined rows, got:\n%s", stdout.String())
+	}
+
+	if err := Run([]string{"join", "-on", "customer", orders}, &stdout, &stderr); err == nil {
+		t.Error("Expected error for a single input file")
+	}
+}
+
+func TestRunAppend(t *testing.T) {
+	dir := t.TempDir()
+	writeCSV(t, dir, "jan.csv", "Customer Name,Amount\nAli,10\n")
+	writeCSV(t, dir, "feb.csv", "customer_name,amount,Note\nAyşe,20,late\n")
+
+	var stdout, stderr bytes.Buffer
+	args := []string{"append", "-normalize", "-source", "file", filepath.Join(dir, "*.csv")}
+	if err := Run(args, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v (%s)", err, stderr.String())
+	}
+
+	want := "customer_name,amount,Note,file\nAyşe,20,late,feb.csv\nAli,10,,jan.csv\n"
+	if stdout.String() != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "jan.csv: missing Note") {
+		t.Errorf("Expected schema mismatch report, got:\n%s", stderr.String())
+	}
+}
+
+func TestRunSplit(t *testing.T) {
+	dir := t.TempDir()
+	input := writeCSV(t, dir, "sales.csv", "region,amount\nNorth,10\nSouth,20\nNorth,30\n")
+	out := filepath.Join(dir, "parts")
+
+	var stdout, stderr bytes.Buffer
+	if err := Run([]string{"split", "-by", "region", "-dir", out, input}, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v (%s)", err, stderr.String())
+	}
+
+	data, err := os.ReadFile(filepath.Join(out, "sales_North.csv"))
+	if err != nil || string(data) != "region,amount\nNorth,10\nNorth,30\n" {
+		t.Errorf("Unexpected North file: %q (%v)", data, err)
+	}
+	if !strings.Contains(stdout.String(), "2 files written") {
+		t.Errorf("Expected 2 files, got:\n%s", stdout.String())
+	}
+
+	workbook := filepath.Join(dir, "chunks.xlsx")
+	stdout.Reset()
+	if err := Run([]string{"split", "-rows", "2", "-workbook", workbook, input}, &stdout, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "2 sheets written") {
+		t.Errorf("Expected 2 sheets, got:\n%s", stdout.String())
+	}
+
+	if err := Run([]string{"split", input}, &stdout, &stderr); err == nil {
+		t.Error("Expected error without -by or -rows")
+	}
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/file"
+	"github.com/veliulugut/snapclean/internal/reshaper"
+)
+
+const joinUsage = "join -on key[=right_key][,...] [-type inner|left|right|outer|anti] [-suffix _right] [-ignore-case] [-o file] <left> <right>"
+
+// maxUnmatchedShown limits the unmatched keys printed per side
+const maxUnmatchedShown = 20
+
+// runJoin joins two files on key columns and writes the result
+func runJoin(args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("join", joinUsage, stderr)
+	on := fs.String("on", "", "key columns, comma separated; use left=right when the names differ")
+	joinType := fs.String("type", string(reshaper.JoinLeft), "join type: inner, left, right, outer or anti")
+	suffix := fs.String("suffix", reshaper.DefaultJoinSuffix, "suffix for right headers that clash with left headers")
+	ignoreCase := fs.Bool("ignore-case", false, "match keys case-insensitively")
+	output := fs.String("o", "", "output file (.csv or .xlsx); CSV on stdout when empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return fmt.Errorf("expected two input files, got %d arguments", fs.NArg())
+	}
+	leftKeys, rightKeys, err := reshaper.ParseJoinKeys(*on)
+	if err != nil {
+		return err
+	}
+
+	left, err := loadTable(strings.TrimSpace(fs.Arg(0)))
+	if err != nil {
+		return err
+	}
+	right, err := loadTable(strings.TrimSpace(fs.Arg(1)))
+	if err != nil {
+		return err
+	}
+
+	result, err := reshaper.Join(left, right, reshaper.JoinOptions{
+		Type:        reshaper.JoinType(*joinType),
+		LeftKeys:    leftKeys,
+		RightKeys:   rightKeys,
+		RightSuffix: *suffix,
+		IgnoreCase:  *ignoreCase,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The summary goes to stderr so stdout stays valid CSV
+	summary := stderr
+	if *output != "" {
+		if err := file.SaveFile(result.Table, *output); err != nil {
+			return err
+		}
+		summary = stdout
+		fmt.Fprintf(summary, "Joined table written to %s (%d rows)\n", *output, result.Table.RowCount())
+	} else if err := file.WriteCSV(stdout, result.Table); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(summary, "%d of %d left rows matched\n", result.Matched, left.RowCount())
+	printUnmatched(summary, "left", result.UnmatchedLeft)
+	printUnmatched(summary, "right", result.UnmatchedRight)
+	return nil
+}
+
+// printUnmatched lists the keys of one side that found no partner
+func printUnmatched(w io.Writer, side string, keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+	shown := keys
+	if len(shown) > maxUnmatchedShown {
+		shown = shown[:maxUnmatchedShown]
+	}
+	fmt.Fprintf(w, "Unmatched %s keys (%d): %s", side, len(keys), strings.Join(shown, ", "))
+	if len(shown) < len(keys) {
+		fmt.Fprint(w, ", ...")
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/report"
+)
+
+const reportUsage = "report [-o file] [-format html|md|json] [-rules rules.json] <input>"
+
+// runReport validates a file and writes a data quality report
+func runReport(args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("report", reportUsage, stderr)
+	output := fs.String("o", "", "output file (format taken from the extension); stdout when empty")
+	format := fs.String("format", "", "output format: html, md or json (default md, or from -o)")
+	rulesPath := fs.String("rules", "", "validation rules file (JSON)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	input, err := inputArg(fs)
+	if err != nil {
+		return err
+	}
+
+	dt, err := loadTable(input)
+	if err != nil {
+		return err
+	}
+
+	var rules cleaner.RuleSet
+	if *rulesPath != "" {
+		if rules, err = cleaner.LoadRules(*rulesPath); err != nil {
+			return err
+		}
+	}
+
+	r, err := report.New(dt, rules.Rules)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		f := report.Format(*format)
+		if f == "" {
+			f = report.FormatMarkdown
+		}
+		return r.Write(stdout, f)
+	}
+
+	if *format != "" {
+		if f, err := report.FormatFromPath(*output); err != nil || string(f) != *format {
+			return fmt.Errorf("-format %s does not match the extension of %s", *format, *output)
+		}
+	}
+	if err := r.Save(*output); err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "Report written to %s (%d issues)\n", *output, len(r.Issues))
+	return nil
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/reshaper"
+)
+
+const splitUsage = "split (-by column[,...] | -rows N) [-dir out] [-prefix name] [-format csv|xlsx] [-workbook file.xlsx] <input>"
+
+// runSplit writes one file or sheet per column value or chunk of rows
+func runSplit(args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("split", splitUsage, stderr)
+	by := fs.String("by", "", "split by the distinct values of these columns, comma separated")
+	rows := fs.Int("rows", 0, "split into chunks of this many rows")
+	dir := fs.String("dir", ".", "output directory for the files")
+	prefix := fs.String("prefix", "", "file name prefix (default: input file name)")
+	format := fs.String("format", "", "output format: csv or xlsx (default: input format)")
+	workbook := fs.String("workbook", "", "write one Excel file with a sheet per part instead of separate files")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	input, err := inputArg(fs)
+	if err != nil {
+		return err
+	}
+
+	dt, err := loadTable(input)
+	if err != nil {
+		return err
+	}
+
+	var columns []string
+	for _, column := range strings.Split(*by, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	parts, err := reshaper.PartitionTable(dt, reshaper.PartitionOptions{Columns: columns, ChunkSize: *rows})
+	if err != nil {
+		return err
+	}
+
+	if *workbook != "" {
+		if err := reshaper.SavePartitionsWorkbook(parts, *workbook); err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "%d sheets written to %s\n", len(parts), *workbook)
+		return nil
+	}
+
+	ext := filepath.Ext(input)
+	if *format != "" {
+		ext = *format
+	}
+	name := *prefix
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(input), filepath.Ext(input))
+	}
+
+	paths, err := reshaper.SavePartitions(parts, *dir, name, ext)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		fmt.Fprintln(stdout, path)
+	}
+	fmt.Fprintf(stdout, "%d files written\n", len(paths))
+	return nil
+}
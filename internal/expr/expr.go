@@ -0,0 +1,324 @@
+// Package expr implements a small expression language evaluated over table rows.
+//
+// Columns are referenced by header, either bare (price) or in brackets
+// ([Unit Price]). Supported are arithmetic (+ - * / %), comparisons
+// (== != < <= > >=), logic (and/or/not, && || !), string, number, date and
+// conditional functions such as upper(city) or if(status == "X", "closed", status).
+// Conditions can also test patterns and lists: matches(code, "^TR[0-9]+$"), in(city, "Ankara", "İzmir").
+package expr
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Program is a compiled expression bound to a set of headers
+type Program struct {
+	source string
+	root   node
+	isNull func(col int, cell string) bool
+}
+
+// rowEnv is the row an expression is evaluated for
+type rowEnv struct {
+	cells  []string
+	isNull func(col int, cell string) bool // extra null check, blank cells are always null
+}
+
+// Compile parses an expression and resolves column references against headers
+func Compile(source string, headers []string) (*Program, error) {
+	if strings.TrimSpace(source) == "" {
+		return nil, fmt.Errorf("expression is empty")
+	}
+
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(headers))
+	for i, h := range headers {
+		if _, exists := index[h]; !exists {
+			index[h] = i
+		}
+	}
+
+	p := &parser{tokens: tokens, headers: index}
+	root, err := p.parseExpression(1)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos+1)
+	}
+
+	return &Program{source: source, root: root}, nil
+}
+
+// WithNulls returns the program reading cells for which isNull is true as null,
+// so expressions see the same missing values as the table (e.g. DataTable.IsMissing)
+func (p *Program) WithNulls(isNull func(col int, cell string) bool) *Program {
+	q := *p
+	q.isNull = isNull
+	return &q
+}
+
+// String returns the source of the expression
+func (p *Program) String() string { return p.source }
+
+// Eval evaluates the expression for one row
+func (p *Program) Eval(row []string) (Value, error) {
+	return eval(p.root, rowEnv{cells: row, isNull: p.isNull})
+}
+
+// Match evaluates the expression as a condition for one row
+func (p *Program) Match(row []string) (bool, error) {
+	v, err := p.Eval(row)
+	if err != nil {
+		return false, err
+	}
+	return v.Truthy(), nil
+}
+
+func eval(n node, env rowEnv) (Value, error) {
+	switch n := n.(type) {
+	case *literalNode:
+		return n.value, nil
+
+	case *columnNode:
+		if n.index >= len(env.cells) {
+			return Null(), nil
+		}
+		cell := env.cells[n.index]
+		if env.isNull != nil && env.isNull(n.index, cell) {
+			return Null(), nil
+		}
+		return cellValue(cell), nil
+
+	case *unaryNode:
+		v, err := eval(n.operand, env)
+		if err != nil {
+			return Null(), err
+		}
+		if n.op == "!" {
+			return Bool(!v.Truthy()), nil
+		}
+		if v.IsNull() {
+			return Null(), nil
+		}
+		num, err := number(v)
+		return Number(-num), err
+
+	case *binaryNode:
+		return evalBinary(n, env)
+
+	case *callNode:
+		return evalCall(n, env)
+	}
+
+	return Null(), fmt.Errorf("invalid expression")
+}
+
+func evalBinary(n *binaryNode, env rowEnv) (Value, error) {
+	left, err := eval(n.left, env)
+	if err != nil {
+		return Null(), err
+	}
+
+	// Short-circuit logic
+	switch n.op {
+	case "&&":
+		if !left.Truthy() {
+			return Bool(false), nil
+		}
+		right, err := eval(n.right, env)
+		return Bool(right.Truthy()), err
+	case "||":
+		if left.Truthy() {
+			return Bool(true), nil
+		}
+		right, err := eval(n.right, env)
+		return Bool(right.Truthy()), err
+	}
+
+	right, err := eval(n.right, env)
+	if err != nil {
+		return Null(), err
+	}
+
+	switch n.op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		cmp, ok := compare(left, right)
+		if !ok {
+			// Ordering against a missing value is never true
+			return Bool(n.op == "!=" && !(left.IsNull() && right.IsNull())), nil
+		}
+		switch n.op {
+		case "==":
+			return Bool(cmp == 0), nil
+		case "!=":
+			return Bool(cmp != 0), nil
+		case "<":
+			return Bool(cmp < 0), nil
+		case "<=":
+			return Bool(cmp <= 0), nil
+		case ">":
+			return Bool(cmp > 0), nil
+		default:
+			return Bool(cmp >= 0), nil
+		}
+	}
+
+	// Arithmetic: null propagates
+	if left.IsNull() || right.IsNull() {
+		return Null(), nil
+	}
+
+	if n.op == "+" {
+		// Adding text joins it, adding numbers sums them
+		ln, lok := left.asNumber()
+		rn, rok := right.asNumber()
+		if !lok || !rok {
+			return String(left.String() + right.String()), nil
+		}
+		return Number(ln + rn), nil
+	}
+
+	ln, err := number(left)
+	if err != nil {
+		return Null(), err
+	}
+	rn, err := number(right)
+	if err != nil {
+		return Null(), err
+	}
+
+	switch n.op {
+	case "-":
+		return Number(ln - rn), nil
+	case "*":
+		return Number(ln * rn), nil
+	case "/":
+		if rn == 0 {
+			return Null(), fmt.Errorf("division by zero")
+		}
+		return Number(ln / rn), nil
+	case "%":
+		if rn == 0 {
+			return Null(), fmt.Errorf("division by zero")
+		}
+		return Number(math.Mod(ln, rn)), nil
+	}
+
+	return Null(), fmt.Errorf("unknown operator %q", n.op)
+}
+
+func evalCall(n *callNode, env rowEnv) (Value, error) {
+	// Lazily evaluated functions
+	switch n.name {
+	case "if":
+		cond, err := eval(n.args[0], env)
+		if err != nil {
+			return Null(), err
+		}
+		if cond.Truthy() {
+			return eval(n.args[1], env)
+		}
+		return eval(n.args[2], env)
+
+	case "coalesce":
+		for _, arg := range n.args {
+			v, err := eval(arg, env)
+			if err != nil {
+				return Null(), err
+			}
+			if !v.IsNull() {
+				return v, nil
+			}
+		}
+		return Null(), nil
+
+	case "isnull":
+		v, err := eval(n.args[0], env)
+		return Bool(v.IsNull()), err
+
+	case "matches":
+		if n.regex != nil {
+			v, err := eval(n.args[0], env)
+			return Bool(!v.IsNull() && n.regex.MatchString(v.String())), err
+		}
+	}
+
+	args := make([]Value, len(n.args))
+	for i, arg := range n.args {
+		v, err := eval(arg, env)
+		if err != nil {
+			return Null(), err
+		}
+		args[i] = v
+	}
+
+	v, err := functions[n.name].call(args)
+	if err != nil {
+		return Null(), fmt.Errorf("%s: %w", n.name, err)
+	}
+	return v, nil
+}
+
+// compare orders two values: numerically when both are numbers, by time when
+// both are dates, otherwise as text. Returns false when either side is null
+// (two nulls compare equal).
+func compare(a, b Value) (int, bool) {
+	if a.IsNull() || b.IsNull() {
+		if a.IsNull() && b.IsNull() {
+			return 0, true
+		}
+		// A null equals an empty string literal
+		other := a
+		if a.IsNull() {
+			other = b
+		}
+		if other.kind == KindString && other.str == "" {
+			return 0, true
+		}
+		return 0, false
+	}
+
+	if an, ok := a.asNumber(); ok {
+		if bn, ok := b.asNumber(); ok {
+			return compareOrdered(an, bn), true
+		}
+	}
+
+	if a.kind == KindDate || b.kind == KindDate {
+		if at, ok := a.asDate(); ok {
+			if bt, ok := b.asDate(); ok {
+				return at.Compare(bt), true
+			}
+		}
+	}
+
+	if a.kind == KindBool || b.kind == KindBool {
+		return compareOrdered(boolNum(a.Truthy()), boolNum(b.Truthy())), true
+	}
+
+	return strings.Compare(a.String(), b.String()), true
+}
+
+func compareOrdered(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func boolNum(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
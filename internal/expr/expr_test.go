@@ -0,0 +1,134 @@
+package expr
+
+import "testing"
+
+var testHeaders = []string{"price", "qty", "city", "status", "Unit Price", "start", "end"}
+
+func TestEval(t *testing.T) {
+	row := []string{"2.5", "4", "istanbul", "X", "10", "2024-01-01", "2024-01-31"}
+
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"price * qty", "10"},
+		{"price * qty + 1", "11"},
+		{"(price + 1) * 2", "7"},
+		{"-qty % 3", "-1"},
+		{"[Unit Price] / 4", "2.5"},
+		{"upper(city)", "ISTANBUL"},
+		{`if(status == "X", "closed", status)`, "closed"},
+		{`if(status = "Y", "closed", status)`, "X"},
+		{`city + "-" + status`, "istanbul-X"},
+		{`concat(upper(substr(city, 1, 1)), substr(city, 2))`, "Istanbul"},
+		{"round(price / 3, 2)", "0.83"},
+		{"max(price, qty, 3)", "4"},
+		{"datediff(end, start)", "30"},
+		{`adddays(start, 10)`, "2024-01-11"},
+		{"year(date(start))", "2024"},
+		{"len(city) > 5 and not qty < 2", "true"},
+		{`coalesce(null, city)`, "istanbul"},
+		{`matches(city, "^ist")`, "true"},
+		{`matches(city, "^" + substr(city, 1, 3))`, "true"},
+		{`matches(status, "(?i)^x$") and in(city, "ankara", "istanbul")`, "true"},
+		{`in(qty, 1, 2, 3)`, "false"},
+		{`in(qty, 4.0)`, "true"},
+	}
+
+	for _, tt := range tests {
+		p, err := Compile(tt.src, testHeaders)
+		if err != nil {
+			t.Errorf("%s: compile error: %v", tt.src, err)
+			continue
+		}
+		v, err := p.Eval(row)
+		if err != nil {
+			t.Errorf("%s: eval error: %v", tt.src, err)
+			continue
+		}
+		if v.String() != tt.want {
+			t.Errorf("%s: want %q, got %q", tt.src, tt.want, v.String())
+		}
+	}
+}
+
+func TestEvalNulls(t *testing.T) {
+	row := []string{"", "4", "", "", "", "", ""}
+
+	tests := []struct {
+		src  string
+		want bool
+	}{
+		{"isnull(price)", true},
+		{`city == ""`, true},
+		{"price > 1", false},
+		{"price < 1", false},
+		{"price != 1", true},
+		{"isnull(price * qty)", true},
+	}
+
+	for _, tt := range tests {
+		p, err := Compile(tt.src, testHeaders)
+		if err != nil {
+			t.Fatalf("%s: compile error: %v", tt.src, err)
+		}
+		got, err := p.Match(row)
+		if err != nil {
+			t.Fatalf("%s: eval error: %v", tt.src, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: want %v, got %v", tt.src, tt.want, got)
+		}
+	}
+}
+
+func TestEvalWithNulls(t *testing.T) {
+	row := []string{"N/A", "4", "", "", "", "", ""}
+	isNull := func(col int, cell string) bool { return cell == "N/A" }
+
+	p, err := Compile("isnull(price)", testHeaders)
+	if err != nil {
+		t.Fatalf("compile error: %v", err)
+	}
+	if got, _ := p.Match(row); got {
+		t.Error("Expected a marker to be a value without a null check")
+	}
+	if got, _ := p.WithNulls(isNull).Match(row); !got {
+		t.Error("Expected a marker to be null with a null check")
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	bad := []string{
+		"",
+		"price *",
+		"unknown_col + 1",
+		"nosuchfn(price)",
+		"upper(city, qty)",
+		`"unterminated`,
+		"(price + 1",
+		"price $ 2",
+		"price qty",
+		`matches(price, "(")`,
+	}
+
+	for _, src := range bad {
+		if _, err := Compile(src, testHeaders); err == nil {
+			t.Errorf("%q: expected compile error", src)
+		}
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	row := []string{"abc", "0", "", "", "", "not a date", ""}
+
+	for _, src := range []string{"price * 2", "qty / qty", "year(start)", `matches(price, concat("(", qty))`} {
+		p, err := Compile(src, testHeaders)
+		if err != nil {
+			t.Fatalf("%s: compile error: %v", src, err)
+		}
+		if _, err := p.Eval(row); err == nil {
+			t.Errorf("%s: expected eval error", src)
+		}
+	}
+}
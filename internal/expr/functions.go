@@ -0,0 +1,259 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// function describes a built-in function
+type function struct {
+	minArgs int
+	maxArgs int // -1 for variadic
+	call    func(args []Value) (Value, error)
+}
+
+func (f function) arity() string {
+	switch {
+	case f.maxArgs < 0:
+		return fmt.Sprintf("at least %d arguments", f.minArgs)
+	case f.minArgs == f.maxArgs:
+		return fmt.Sprintf("%d arguments", f.minArgs)
+	default:
+		return fmt.Sprintf("%d to %d arguments", f.minArgs, f.maxArgs)
+	}
+}
+
+// functions lists the built-in functions; if, coalesce and isnull are
+// evaluated lazily by the evaluator but still listed here for parsing
+var functions = map[string]function{
+	// Conditionals
+	"if":       {3, 3, nil},
+	"coalesce": {1, -1, nil},
+	"isnull":   {1, 1, nil},
+
+	// Strings
+	"upper":      {1, 1, stringFn(strings.ToUpper)},
+	"lower":      {1, 1, stringFn(strings.ToLower)},
+	"trim":       {1, 1, stringFn(strings.TrimSpace)},
+	"len":        {1, 1, func(a []Value) (Value, error) { return Number(float64(len([]rune(a[0].String())))), nil }},
+	"concat":     {1, -1, concatFn},
+	"substr":     {2, 3, substrFn},
+	"replace":    {3, 3, replaceFn},
+	"contains":   {2, 2, func(a []Value) (Value, error) { return Bool(strings.Contains(a[0].String(), a[1].String())), nil }},
+	"startswith": {2, 2, func(a []Value) (Value, error) { return Bool(strings.HasPrefix(a[0].String(), a[1].String())), nil }},
+	"endswith":   {2, 2, func(a []Value) (Value, error) { return Bool(strings.HasSuffix(a[0].String(), a[1].String())), nil }},
+	"matches":    {2, 2, matchesFn},
+	"in":         {2, -1, inFn},
+
+	// Numbers
+	"num":   {1, 1, func(a []Value) (Value, error) { n, err := number(a[0]); return Number(n), err }},
+	"abs":   {1, 1, mathFn(math.Abs)},
+	"floor": {1, 1, mathFn(math.Floor)},
+	"ceil":  {1, 1, mathFn(math.Ceil)},
+	"round": {1, 2, roundFn},
+	"min":   {1, -1, extremeFn(func(a, b float64) bool { return a < b })},
+	"max":   {1, -1, extremeFn(func(a, b float64) bool { return a > b })},
+
+	// Dates
+	"date":     {1, 1, func(a []Value) (Value, error) { t, err := date(a[0]); return Date(t), err }},
+	"today":    {0, 0, todayFn},
+	"year":     {1, 1, datePartFn(func(t time.Time) int { return t.Year() })},
+	"month":    {1, 1, datePartFn(func(t time.Time) int { return int(t.Month()) })},
+	"day":      {1, 1, datePartFn(func(t time.Time) int { return t.Day() })},
+	"datediff": {2, 2, dateDiffFn},
+	"adddays":  {2, 2, addDaysFn},
+}
+
+// Functions returns the names of all built-in functions, sorted
+func Functions() []string {
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func stringFn(f func(string) string) func([]Value) (Value, error) {
+	return func(a []Value) (Value, error) {
+		if a[0].IsNull() {
+			return Null(), nil
+		}
+		return String(f(a[0].String())), nil
+	}
+}
+
+func mathFn(f func(float64) float64) func([]Value) (Value, error) {
+	return func(a []Value) (Value, error) {
+		if a[0].IsNull() {
+			return Null(), nil
+		}
+		n, err := number(a[0])
+		return Number(f(n)), err
+	}
+}
+
+func datePartFn(f func(time.Time) int) func([]Value) (Value, error) {
+	return func(a []Value) (Value, error) {
+		if a[0].IsNull() {
+			return Null(), nil
+		}
+		t, err := date(a[0])
+		return Number(float64(f(t))), err
+	}
+}
+
+func concatFn(a []Value) (Value, error) {
+	var sb strings.Builder
+	for _, v := range a {
+		sb.WriteString(v.String())
+	}
+	return String(sb.String()), nil
+}
+
+func substrFn(a []Value) (Value, error) {
+	runes := []rune(a[0].String())
+	start, err := number(a[1])
+	if err != nil {
+		return Null(), err
+	}
+	from := clamp(int(start)-1, 0, len(runes)) // 1-based like spreadsheets
+	to := len(runes)
+	if len(a) == 3 {
+		length, err := number(a[2])
+		if err != nil {
+			return Null(), err
+		}
+		to = clamp(from+int(length), from, len(runes))
+	}
+	return String(string(runes[from:to])), nil
+}
+
+func replaceFn(a []Value) (Value, error) {
+	return String(strings.ReplaceAll(a[0].String(), a[1].String(), a[2].String())), nil
+}
+
+// matchesFn reports whether a value matches a regular expression; a missing value never matches
+// Literal patterns are compiled once by the parser, so this only runs for patterns built per row
+func matchesFn(a []Value) (Value, error) {
+	if a[0].IsNull() {
+		return Bool(false), nil
+	}
+
+	re, err := regexp.Compile(a[1].String())
+	if err != nil {
+		return Null(), fmt.Errorf("invalid pattern: %w", err)
+	}
+	return Bool(re.MatchString(a[0].String())), nil
+}
+
+// inFn reports whether the first value equals any of the others, compared like ==
+func inFn(a []Value) (Value, error) {
+	for _, v := range a[1:] {
+		if cmp, ok := compare(a[0], v); ok && cmp == 0 {
+			return Bool(true), nil
+		}
+	}
+	return Bool(false), nil
+}
+
+func roundFn(a []Value) (Value, error) {
+	if a[0].IsNull() {
+		return Null(), nil
+	}
+	n, err := number(a[0])
+	if err != nil {
+		return Null(), err
+	}
+	digits := 0.0
+	if len(a) == 2 {
+		if digits, err = number(a[1]); err != nil {
+			return Null(), err
+		}
+	}
+	scale := math.Pow(10, digits)
+	return Number(math.Round(n*scale) / scale), nil
+}
+
+func extremeFn(better func(a, b float64) bool) func([]Value) (Value, error) {
+	return func(a []Value) (Value, error) {
+		result, found := 0.0, false
+		for _, v := range a {
+			if v.IsNull() {
+				continue
+			}
+			n, err := number(v)
+			if err != nil {
+				return Null(), err
+			}
+			if !found || better(n, result) {
+				result, found = n, true
+			}
+		}
+		if !found {
+			return Null(), nil
+		}
+		return Number(result), nil
+	}
+}
+
+func todayFn([]Value) (Value, error) {
+	y, m, d := time.Now().Date()
+	return Date(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)), nil
+}
+
+func dateDiffFn(a []Value) (Value, error) {
+	if a[0].IsNull() || a[1].IsNull() {
+		return Null(), nil
+	}
+	end, err := date(a[0])
+	if err != nil {
+		return Null(), err
+	}
+	start, err := date(a[1])
+	if err != nil {
+		return Null(), err
+	}
+	return Number(math.Round(end.Sub(start).Hours() / 24)), nil
+}
+
+func addDaysFn(a []Value) (Value, error) {
+	if a[0].IsNull() {
+		return Null(), nil
+	}
+	t, err := date(a[0])
+	if err != nil {
+		return Null(), err
+	}
+	days, err := number(a[1])
+	if err != nil {
+		return Null(), err
+	}
+	return Date(t.AddDate(0, 0, int(days))), nil
+}
+
+// number converts a value to a number or explains why it cannot
+func number(v Value) (float64, error) {
+	n, ok := v.asNumber()
+	if !ok {
+		return 0, fmt.Errorf("%q is not a number", v.String())
+	}
+	return n, nil
+}
+
+// date converts a value to a date or explains why it cannot
+func date(v Value) (time.Time, error) {
+	t, ok := v.asDate()
+	if !ok {
+		return time.Time{}, fmt.Errorf("%q is not a date (use YYYY-MM-DD)", v.String())
+	}
+	return t, nil
+}
+
+func clamp(n, lo, hi int) int {
+	return max(lo, min(n, hi))
+}
@@ -0,0 +1,118 @@
+package expr
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokString
+	tokIdent  // bare identifier: column or function name
+	tokColumn // [Column With Spaces]
+	tokOp
+	tokLParen
+	tokRParen
+	tokComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// operators ordered so that two-character operators match first
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||", "<>", "+", "-", "*", "/", "%", "<", ">", "!", "="}
+
+// lex splits an expression into tokens
+func lex(src string) ([]token, error) {
+	var tokens []token
+	runes := []rune(src)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			tokens = append(tokens, token{tokLParen, "(", i})
+			i++
+
+		case r == ')':
+			tokens = append(tokens, token{tokRParen, ")", i})
+			i++
+
+		case r == ',':
+			tokens = append(tokens, token{tokComma, ",", i})
+			i++
+
+		case r == '"' || r == '\'':
+			start := i
+			var sb strings.Builder
+			i++
+			for i < len(runes) && runes[i] != r {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				sb.WriteRune(runes[i])
+				i++
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", start+1)
+			}
+			i++ // closing quote
+			tokens = append(tokens, token{tokString, sb.String(), start})
+
+		case r == '[':
+			start := i
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("unterminated column reference at position %d", start+1)
+			}
+			tokens = append(tokens, token{tokColumn, string(runes[i+1 : end]), start})
+			i = end + 1
+
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{tokNumber, string(runes[start:i]), start})
+
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{tokIdent, string(runes[start:i]), start})
+
+		default:
+			matched := false
+			rest := string(runes[i:])
+			for _, op := range operators {
+				if strings.HasPrefix(rest, op) {
+					tokens = append(tokens, token{tokOp, op, i})
+					i += len([]rune(op))
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at position %d", r, i+1)
+			}
+		}
+	}
+
+	tokens = append(tokens, token{tokEOF, "", len(runes)})
+	return tokens, nil
+}
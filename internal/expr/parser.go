@@ -0,0 +1,240 @@
+package expr
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// node is an element of the parsed expression tree
+type node interface{}
+
+type literalNode struct{ value Value }
+
+type columnNode struct {
+	name  string
+	index int
+}
+
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+type binaryNode struct {
+	op          string
+	left, right node
+}
+
+type callNode struct {
+	name  string
+	args  []node
+	regex *regexp.Regexp // matches() pattern compiled once when it is a literal
+}
+
+// parser is a precedence-climbing parser over the token list
+type parser struct {
+	tokens  []token
+	pos     int
+	headers map[string]int
+}
+
+// binary operator precedence (higher binds tighter)
+var precedence = map[string]int{
+	"||": 1, "or": 1,
+	"&&": 2, "and": 2,
+	"==": 3, "=": 3, "!=": 3, "<>": 3, "<": 3, "<=": 3, ">": 3, ">=": 3,
+	"+": 4, "-": 4,
+	"*": 5, "/": 5, "%": 5,
+}
+
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// binaryOp returns the normalized operator at the current token, if any
+func (p *parser) binaryOp() (string, bool) {
+	t := p.peek()
+	switch {
+	case t.kind == tokOp:
+		if _, ok := precedence[t.text]; ok {
+			return t.text, true
+		}
+	case t.kind == tokIdent:
+		word := strings.ToLower(t.text)
+		if word == "and" || word == "or" {
+			return word, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) parseExpression(minPrec int) (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op, ok := p.binaryOp()
+		if !ok || precedence[op] < minPrec {
+			return left, nil
+		}
+		p.next()
+
+		right, err := p.parseExpression(precedence[op] + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: normalizeOp(op), left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	t := p.peek()
+	if (t.kind == tokOp && (t.text == "-" || t.text == "!")) ||
+		(t.kind == tokIdent && strings.EqualFold(t.text, "not")) {
+		p.next()
+		// "not" binds looser than comparisons, so "not a == b" negates the comparison
+		var operand node
+		var err error
+		if t.kind == tokIdent {
+			operand, err = p.parseExpression(precedence["=="])
+		} else {
+			operand, err = p.parseUnary()
+		}
+		if err != nil {
+			return nil, err
+		}
+		op := "-"
+		if t.text != "-" {
+			op = "!"
+		}
+		return &unaryNode{op: op, operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokNumber:
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", t.text, t.pos+1)
+		}
+		return &literalNode{value: Number(n)}, nil
+
+	case tokString:
+		return &literalNode{value: String(t.text)}, nil
+
+	case tokColumn:
+		return p.column(t)
+
+	case tokIdent:
+		switch strings.ToLower(t.text) {
+		case "true":
+			return &literalNode{value: Bool(true)}, nil
+		case "false":
+			return &literalNode{value: Bool(false)}, nil
+		case "null":
+			return &literalNode{value: Null()}, nil
+		}
+
+		if p.peek().kind == tokLParen {
+			return p.call(t)
+		}
+		return p.column(t)
+
+	case tokLParen:
+		inner, err := p.parseExpression(1)
+		if err != nil {
+			return nil, err
+		}
+		if p.next().kind != tokRParen {
+			return nil, fmt.Errorf("missing ')' for '(' at position %d", t.pos+1)
+		}
+		return inner, nil
+
+	case tokEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos+1)
+	}
+}
+
+// column resolves a column reference against the headers
+func (p *parser) column(t token) (node, error) {
+	idx, ok := p.headers[t.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown column %q at position %d", t.text, t.pos+1)
+	}
+	return &columnNode{name: t.text, index: idx}, nil
+}
+
+// call parses a function call whose name token has been consumed
+func (p *parser) call(name token) (node, error) {
+	fnName := strings.ToLower(name.text)
+	fn, ok := functions[fnName]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %d", name.text, name.pos+1)
+	}
+
+	p.next() // (
+	var args []node
+	if p.peek().kind != tokRParen {
+		for {
+			arg, err := p.parseExpression(1)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+
+			if p.peek().kind != tokComma {
+				break
+			}
+			p.next()
+		}
+	}
+	if p.next().kind != tokRParen {
+		return nil, fmt.Errorf("missing ')' after arguments of %s", fnName)
+	}
+
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, fmt.Errorf("%s expects %s, got %d", fnName, fn.arity(), len(args))
+	}
+
+	call := &callNode{name: fnName, args: args}
+	if lit, ok := args[len(args)-1].(*literalNode); ok && fnName == "matches" {
+		re, err := regexp.Compile(lit.value.String())
+		if err != nil {
+			return nil, fmt.Errorf("matches: invalid pattern: %w", err)
+		}
+		call.regex = re
+	}
+	return call, nil
+}
+
+// normalizeOp maps operator aliases to one spelling
+func normalizeOp(op string) string {
+	switch op {
+	case "=":
+		return "=="
+	case "<>":
+		return "!="
+	case "and":
+		return "&&"
+	case "or":
+		return "||"
+	}
+	return op
+}
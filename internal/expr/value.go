@@ -0,0 +1,134 @@
+package expr
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kind is the dynamic type of a Value
+type Kind int
+
+const (
+	KindNull Kind = iota
+	KindString
+	KindNumber
+	KindBool
+	KindDate
+)
+
+// Value is the result of evaluating an expression
+type Value struct {
+	kind Kind
+	str  string
+	num  float64
+	b    bool
+	t    time.Time
+}
+
+// Null returns the missing value
+func Null() Value { return Value{kind: KindNull} }
+
+// String returns a text value
+func String(s string) Value { return Value{kind: KindString, str: s} }
+
+// Number returns a numeric value
+func Number(n float64) Value { return Value{kind: KindNumber, num: n} }
+
+// Bool returns a boolean value
+func Bool(b bool) Value { return Value{kind: KindBool, b: b} }
+
+// Date returns a date value
+func Date(t time.Time) Value { return Value{kind: KindDate, t: t} }
+
+// cellValue converts a raw cell into a value; blank cells are null
+func cellValue(cell string) Value {
+	if strings.TrimSpace(cell) == "" {
+		return Null()
+	}
+	return String(cell)
+}
+
+// Kind returns the dynamic type of the value
+func (v Value) Kind() Kind { return v.kind }
+
+// IsNull reports whether the value is missing
+func (v Value) IsNull() bool { return v.kind == KindNull }
+
+// String formats the value for writing into a cell
+func (v Value) String() string {
+	switch v.kind {
+	case KindString:
+		return v.str
+	case KindNumber:
+		return strconv.FormatFloat(v.num, 'f', -1, 64)
+	case KindBool:
+		return strconv.FormatBool(v.b)
+	case KindDate:
+		if v.t.Hour() == 0 && v.t.Minute() == 0 && v.t.Second() == 0 {
+			return v.t.Format("2006-01-02")
+		}
+		return v.t.Format("2006-01-02 15:04:05")
+	}
+	return ""
+}
+
+// Truthy reports whether the value counts as true in a condition
+func (v Value) Truthy() bool {
+	switch v.kind {
+	case KindBool:
+		return v.b
+	case KindNumber:
+		return v.num != 0
+	case KindString:
+		s := strings.ToLower(strings.TrimSpace(v.str))
+		return s != "" && s != "false" && s != "0"
+	case KindDate:
+		return true
+	}
+	return false
+}
+
+// asNumber converts the value to a number if possible
+func (v Value) asNumber() (float64, bool) {
+	switch v.kind {
+	case KindNumber:
+		return v.num, true
+	case KindString:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v.str), 64)
+		return n, err == nil
+	case KindBool:
+		if v.b {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// dateLayouts are the formats accepted when text is used as a date
+var dateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	time.RFC3339,
+	"02.01.2006",
+	"02/01/2006",
+	"2006/01/02",
+}
+
+// asDate converts the value to a date if possible
+func (v Value) asDate() (time.Time, bool) {
+	switch v.kind {
+	case KindDate:
+		return v.t, true
+	case KindString:
+		s := strings.TrimSpace(v.str)
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
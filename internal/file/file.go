@@ -3,6 +3,7 @@ package file
 import (
 	"encoding/csv"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -125,3 +126,124 @@ func LoadExcel(filePath string) (*models.DataTable, error) {
 
 	return table, nil
 }
+
+// SaveFile detects the file type from the extension and saves the table
+func SaveFile(dt *models.DataTable, filePath string) error {
+	if filePath == "" {
+		return fmt.Errorf("file path is empty")
+	}
+
+	ext := strings.ToLower(filepath.Ext(filePath))
+
+	switch ext {
+	case ".csv":
+		return SaveCSV(dt, filePath)
+	case ".xlsx":
+		return SaveExcel(dt, filePath)
+	default:
+		return fmt.Errorf("unsupported file format: %s (supported: .csv, .xlsx)", ext)
+	}
+}
+
+// SaveCSV writes the table to a CSV file
+func SaveCSV(dt *models.DataTable, filePath string) error {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	if err := WriteCSV(file, dt); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+// WriteCSV writes the table as CSV, headers first
+func WriteCSV(w io.Writer, dt *models.DataTable) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(dt.Headers); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	if err := writer.WriteAll(dt.Rows); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return nil
+}
+
+// Sheet is a named table written to one sheet of a workbook
+type Sheet struct {
+	Name  string
+	Table *models.DataTable
+}
+
+// SaveExcel writes the table to the first sheet of an Excel file
+func SaveExcel(dt *models.DataTable, filePath string) error {
+	return SaveWorkbook(filePath, []Sheet{{Table: dt}})
+}
+
+// SaveWorkbook writes each table to its own sheet of an Excel file
+// Sheets without a name keep the default name of their position
+func SaveWorkbook(filePath string, sheets []Sheet) error {
+	if len(sheets) == 0 {
+		return fmt.Errorf("no sheets to save")
+	}
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	for i, sheet := range sheets {
+		sheetName := f.GetSheetName(0)
+		if i > 0 {
+			sheetName = fmt.Sprintf("Sheet%d", i+1)
+			if _, err := f.NewSheet(sheetName); err != nil {
+				return fmt.Errorf("failed to create Excel sheet: %w", err)
+			}
+		}
+		if sheet.Name != "" && sheet.Name != sheetName {
+			if err := f.SetSheetName(sheetName, sheet.Name); err != nil {
+				return fmt.Errorf("invalid sheet name %q: %w", sheet.Name, err)
+			}
+			sheetName = sheet.Name
+		}
+
+		if err := writeSheet(f, sheetName, sheet.Table); err != nil {
+			return err
+		}
+	}
+
+	if err := f.SaveAs(filePath); err != nil {
+		return fmt.Errorf("failed to save Excel file: %w", err)
+	}
+
+	return nil
+}
+
+// writeSheet streams the headers and rows of a table into a sheet
+func writeSheet(f *excelize.File, sheetName string, dt *models.DataTable) error {
+	stream, err := f.NewStreamWriter(sheetName)
+	if err != nil {
+		return fmt.Errorf("failed to create Excel sheet: %w", err)
+	}
+
+	rows := append([][]string{dt.Headers}, dt.Rows...)
+	for i, row := range rows {
+		cells := make([]interface{}, len(row))
+		for j, cell := range row {
+			cells[j] = cell
+		}
+		cellName, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := stream.SetRow(cellName, cells); err != nil {
+			return fmt.Errorf("failed to write Excel row %d: %w", i+1, err)
+		}
+	}
+
+	if err := stream.Flush(); err != nil {
+		return fmt.Errorf("failed to write Excel sheet: %w", err)
+	}
+
+	return nil
+}
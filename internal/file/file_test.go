@@ -2,7 +2,11 @@ package file
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/xuri/excelize/v2"
 )
 
 func TestLoadCSV(t *testing.T) {
@@ -111,3 +115,58 @@ Jane,25,LA,Extra`
 		t.Errorf("Expected truncated row length 3, got %d", len(row2))
 	}
 }
+
+func TestSaveFileRoundTrip(t *testing.T) {
+	table := models.NewDataTable([]string{"Name", "Note"})
+	table.AddRow([]string{"John", "says \"hi\", twice"})
+	table.AddRow([]string{"Jane", ""})
+
+	dir := t.TempDir()
+	for _, name := range []string{"out.csv", "out.xlsx"} {
+		path := filepath.Join(dir, name)
+		if err := SaveFile(table, path); err != nil {
+			t.Fatalf("%s: failed to save: %v", name, err)
+		}
+
+		loaded, err := LoadFile(path)
+		if err != nil {
+			t.Fatalf("%s: failed to load: %v", name, err)
+		}
+		if loaded.RowCount() != 2 || loaded.Rows[0][1] != table.Rows[0][1] {
+			t.Errorf("%s: unexpected rows after round trip: %v", name, loaded.Rows)
+		}
+	}
+
+	if err := SaveFile(table, filepath.Join(dir, "out.txt")); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
+
+func TestSaveWorkbook(t *testing.T) {
+	north := models.NewDataTable([]string{"Region"})
+	north.AddRow([]string{"North"})
+	south := models.NewDataTable([]string{"Region"})
+	south.AddRow([]string{"South"})
+
+	path := filepath.Join(t.TempDir(), "regions.xlsx")
+	if err := SaveWorkbook(path, []Sheet{{Name: "North", Table: north}, {Name: "South", Table: south}}); err != nil {
+		t.Fatalf("Failed to save workbook: %v", err)
+	}
+
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		t.Fatalf("Failed to open workbook: %v", err)
+	}
+	defer f.Close()
+
+	if sheets := f.GetSheetList(); len(sheets) != 2 || sheets[0] != "North" || sheets[1] != "South" {
+		t.Errorf("Unexpected sheets: %v", sheets)
+	}
+	if value, _ := f.GetCellValue("South", "A2"); value != "South" {
+		t.Errorf("Expected South in second sheet, got %q", value)
+	}
+
+	if err := SaveWorkbook(path, nil); err == nil {
+		t.Error("Expected error for no sheets")
+	}
+}
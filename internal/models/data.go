@@ -12,19 +12,31 @@ func NewDataTable(headers []string) *DataTable {
 
 // DataTable represent a structered a data table with headers and rows
 type DataTable struct {
-	Headers  []string   // Column headers
-	Rows     [][]string // Data rows
-	FilePath string     // Path to the source file
-	FileName string     // Name of the source file
+	Headers  []string     // Column headers
+	Rows     [][]string   // Data rows
+	FilePath string       // Path to the source file
+	FileName string       // Name of the source file
+	Nulls    *NullMarkers // Values treated as missing (nil means blank cells only)
+}
+
+// CellRef identifies a single cell by its row and column index
+type CellRef struct {
+	Row int // Row index (0-based, excluding headers)
+	Col int // Column index (0-based)
 }
 
 // CleanOptions defines options for data cleaning operations
 type CleanOptions struct {
-	RemoveEmptyRows    bool // Remove rows with all empty cells
-	RemoveEmptyColumns bool // Remove columns with all empty cells
-	NormalizeHeaders   bool // Normalize header names(lowercase,underscores)
-	RemoveDuplicates   bool // Remove duplicate rows
-	TrimWhitespace     bool // Trim leading/trailing whitespace from cells
+	RemoveEmptyRows    bool   // Remove rows with all empty cells
+	RemoveEmptyColumns bool   // Remove columns with all empty cells
+	NormalizeHeaders   bool   // Normalize header names(lowercase,underscores)
+	RemoveDuplicates   bool   // Remove duplicate rows
+	TrimWhitespace     bool   // Trim leading/trailing whitespace from cells
+	CollapseSpaces     bool   // Collapse internal runs of spaces in cells
+	RemoveInvisible    bool   // Remove non-breaking, zero-width and control characters
+	NormalizeUnicode   bool   // Unicode NFC normalization of cells
+	StandardizeNulls   bool   // Replace every null marker with a single representation
+	NullValue          string // Representation used by StandardizeNulls (default empty)
 }
 
 // ExportOptions defines options for exporting data
@@ -71,6 +83,26 @@ func (dt *DataTable) GetColumn(index int) ([]string, error) {
 	return column, nil
 }
 
+// ColumnIndex returns the index of the column with the given header
+// Returns -1 if no such column exists
+func (dt *DataTable) ColumnIndex(header string) int {
+	for i, h := range dt.Headers {
+		if h == header {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsMissing checks if a value in the given column counts as missing
+func (dt *DataTable) IsMissing(colIdx int, value string) bool {
+	header := ""
+	if colIdx >= 0 && colIdx < len(dt.Headers) {
+		header = dt.Headers[colIdx]
+	}
+	return dt.Nulls.IsNull(header, value)
+}
+
 // IsEmpty checks if the table has no data
 func (dt *DataTable) IsEmpty() bool {
 	return len(dt.Rows) == 0
@@ -86,6 +118,18 @@ func (dt *DataTable) GetRow(index int) ([]string, error) {
 	return dt.Rows[index], nil
 }
 
+// ShallowClone copies the table but shares its row slices
+// For operations that replace whole rows; a shared row must never be modified
+func (dt *DataTable) ShallowClone() *DataTable {
+	return &DataTable{
+		Headers:  append([]string(nil), dt.Headers...),
+		Rows:     append([][]string(nil), dt.Rows...),
+		FilePath: dt.FilePath,
+		FileName: dt.FileName,
+		Nulls:    dt.Nulls.Clone(),
+	}
+}
+
 // Clone creates a deep copy of the DataTable
 func (dt *DataTable) Clone() *DataTable {
 	newTable := &DataTable{
@@ -93,6 +137,7 @@ func (dt *DataTable) Clone() *DataTable {
 		Rows:     make([][]string, len(dt.Rows)),
 		FilePath: dt.FilePath,
 		FileName: dt.FileName,
+		Nulls:    dt.Nulls.Clone(),
 	}
 
 	copy(newTable.Headers, dt.Headers)
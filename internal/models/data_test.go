@@ -107,3 +107,75 @@ func TestClone(t *testing.T) {
 		t.Error("Clone was affected by original modification")
 	}
 }
+
+func TestShallowClone(t *testing.T) {
+	dt := NewDataTable([]string{"Name", "Age"})
+	dt.AddRow([]string{"John", "30"})
+
+	clone := dt.ShallowClone()
+	clone.Rows[0] = []string{"Jane", "25"}
+	clone.AddRow([]string{"Ali", "40"})
+	clone.Headers[0] = "First"
+
+	if dt.Rows[0][0] != "John" || dt.RowCount() != 1 || dt.Headers[0] != "Name" {
+		t.Error("Replacing rows of a shallow clone affected the original")
+	}
+}
+
+func TestColumnIndex(t *testing.T) {
+	dt := NewDataTable([]string{"Name", "Age"})
+
+	if idx := dt.ColumnIndex("Age"); idx != 1 {
+		t.Errorf("Expected index 1 for Age, got %d", idx)
+	}
+
+	if idx := dt.ColumnIndex("City"); idx != -1 {
+		t.Errorf("Expected -1 for unknown column, got %d", idx)
+	}
+}
+
+func TestIsMissingWithNullMarkers(t *testing.T) {
+	dt := NewDataTable([]string{"Name", "Score"})
+
+	if !dt.IsMissing(0, "  ") {
+		t.Error("Expected blank cell to be missing")
+	}
+	if dt.IsMissing(0, "NULL") {
+		t.Error("Expected NULL to be a value when no markers are configured")
+	}
+
+	dt.Nulls = DefaultNullMarkers()
+	dt.Nulls.SetColumn("Score", []string{"-1"})
+
+	if !dt.IsMissing(0, " null ") || !dt.IsMissing(0, "#n/a") {
+		t.Error("Expected global markers to match case-insensitively")
+	}
+	if !dt.IsMissing(1, "-1") {
+		t.Error("Expected per-column marker to match")
+	}
+	if dt.IsMissing(0, "-1") {
+		t.Error("Expected per-column marker to only apply to its column")
+	}
+
+	clone := dt.Clone()
+	clone.Nulls.SetColumn("Name", []string{"unknown"})
+	if dt.IsMissing(0, "unknown") {
+		t.Error("Clone shares null markers with original")
+	}
+}
+
+func TestNullMarkersForOtherTable(t *testing.T) {
+	n := &NullMarkers{Global: []string{"NULL", "missing"}}
+	n.SetColumn("Score", []string{"-1"})
+
+	other := n.ForOtherTable()
+	if !other.IsNull("Amount", "missing") || !other.IsNull("Amount", "#N/A") {
+		t.Errorf("Expected defaults and global markers, got %v", other.Global)
+	}
+	if other.IsNull("Score", "-1") {
+		t.Error("Expected per-column markers left out")
+	}
+	if len(other.Global) != len(DefaultNullTokens)+1 {
+		t.Errorf("Expected no duplicate markers, got %v", other.Global)
+	}
+}
@@ -0,0 +1,101 @@
+package models
+
+import "strings"
+
+// DefaultNullTokens are common placeholders that sources use for missing values
+// "NA" is left out because it is also a real value (North America, Namibia)
+var DefaultNullTokens = []string{"NULL", "N/A", "-", "none", "?", "#N/A", "0000-00-00"}
+
+// NullMarkers defines which cell values count as missing
+// Blank and whitespace-only cells are always missing; markers are matched
+// case-insensitively after trimming
+type NullMarkers struct {
+	Global    []string            `json:"global,omitempty"`     // Markers recognized in every column
+	PerColumn map[string][]string `json:"per_column,omitempty"` // Extra markers keyed by column header
+}
+
+// DefaultNullMarkers returns markers recognizing DefaultNullTokens in every column
+func DefaultNullMarkers() *NullMarkers {
+	global := make([]string, len(DefaultNullTokens))
+	copy(global, DefaultNullTokens)
+	return &NullMarkers{Global: global}
+}
+
+// ForOtherTable returns the markers to read another file with: the defaults and
+// these global markers, without the per-column ones that name this table's headers
+func (n *NullMarkers) ForOtherTable() *NullMarkers {
+	markers := DefaultNullMarkers()
+	if n == nil {
+		return markers
+	}
+	for _, marker := range n.Global {
+		if !markers.IsNull("", marker) {
+			markers.Global = append(markers.Global, marker)
+		}
+	}
+	return markers
+}
+
+// IsNull checks if a value is blank or matches a marker for the given column
+func (n *NullMarkers) IsNull(header, value string) bool {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return true
+	}
+	if n == nil {
+		return false
+	}
+
+	for _, marker := range n.Global {
+		if strings.EqualFold(value, marker) {
+			return true
+		}
+	}
+	for _, marker := range n.PerColumn[header] {
+		if strings.EqualFold(value, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetColumn replaces the extra markers of a column
+func (n *NullMarkers) SetColumn(header string, markers []string) {
+	if n.PerColumn == nil {
+		n.PerColumn = make(map[string][]string)
+	}
+	if len(markers) == 0 {
+		delete(n.PerColumn, header)
+		return
+	}
+	n.PerColumn[header] = markers
+}
+
+// RenameColumn moves per-column markers to a new header name
+func (n *NullMarkers) RenameColumn(oldHeader, newHeader string) {
+	if n == nil || oldHeader == newHeader {
+		return
+	}
+	markers, ok := n.PerColumn[oldHeader]
+	if !ok {
+		return
+	}
+	delete(n.PerColumn, oldHeader)
+	n.PerColumn[newHeader] = markers
+}
+
+// Clone creates a deep copy of the markers
+func (n *NullMarkers) Clone() *NullMarkers {
+	if n == nil {
+		return nil
+	}
+
+	clone := &NullMarkers{Global: append([]string(nil), n.Global...)}
+	if n.PerColumn != nil {
+		clone.PerColumn = make(map[string][]string, len(n.PerColumn))
+		for header, markers := range n.PerColumn {
+			clone.PerColumn[header] = append([]string(nil), markers...)
+		}
+	}
+	return clone
+}
@@ -0,0 +1,174 @@
+// Package profiler describes the columns of a data table.
+package profiler
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// Inferred column types, most specific first
+const (
+	TypeEmpty   = "empty"
+	TypeInteger = cleaner.DataInteger
+	TypeNumber  = cleaner.DataNumber
+	TypeDate    = cleaner.DataDate
+	TypeBool    = cleaner.DataBool
+	TypeText    = "text"
+)
+
+// inferOrder is the order types are tried in; the first one matching every value wins
+var inferOrder = []string{TypeInteger, TypeNumber, TypeDate, TypeBool}
+
+// Number of top values and samples kept per column
+const (
+	topValueCount = 5
+	sampleCount   = 5
+)
+
+// ColumnProfile describes one column
+type ColumnProfile struct {
+	Name      string               `json:"name"`
+	Type      string               `json:"type"`
+	Count     int                  `json:"count"`    // Non-missing values
+	Missing   int                  `json:"missing"`  // Missing values (blank or null markers)
+	Distinct  int                  `json:"distinct"` // Distinct non-missing values
+	TopValues []cleaner.ValueCount `json:"top_values"`
+	Numeric   *NumericStats        `json:"numeric,omitempty"` // Set for integer and number columns
+	MinLength int                  `json:"min_length"`        // Shortest value in characters
+	MaxLength int                  `json:"max_length"`        // Longest value in characters
+	Samples   []string             `json:"samples"`           // First distinct values in row order
+}
+
+// NumericStats summarizes a numeric column
+type NumericStats struct {
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	Std    float64 `json:"std"` // Population standard deviation
+}
+
+// Profile describes every column of the table
+func Profile(dt *models.DataTable) []ColumnProfile {
+	profiles := make([]ColumnProfile, 0)
+	if dt == nil {
+		return profiles
+	}
+
+	for colIdx := range dt.Headers {
+		profiles = append(profiles, ProfileColumn(dt, colIdx))
+	}
+	return profiles
+}
+
+// ProfileColumn describes one column by index
+func ProfileColumn(dt *models.DataTable, colIdx int) ColumnProfile {
+	p := ColumnProfile{Name: dt.Headers[colIdx]}
+
+	var values []string
+	counts := make(map[string]int)
+	p.Samples = make([]string, 0, sampleCount)
+	for _, row := range dt.Rows {
+		value := ""
+		if colIdx < len(row) {
+			value = row[colIdx]
+		}
+		if dt.IsMissing(colIdx, value) {
+			p.Missing++
+			continue
+		}
+
+		if counts[value] == 0 && len(p.Samples) < sampleCount {
+			p.Samples = append(p.Samples, value)
+		}
+		counts[value]++
+		values = append(values, value)
+
+		length := len([]rune(value))
+		if len(values) == 1 || length < p.MinLength {
+			p.MinLength = length
+		}
+		p.MaxLength = max(p.MaxLength, length)
+	}
+
+	p.Count = len(values)
+	p.Distinct = len(counts)
+	p.Type = InferType(values)
+	p.TopValues = topValues(counts, topValueCount)
+	if p.Type == TypeInteger || p.Type == TypeNumber {
+		p.Numeric = numericStats(values)
+	}
+	return p
+}
+
+// InferType returns the most specific type matching all values
+func InferType(values []string) string {
+	if len(values) == 0 {
+		return TypeEmpty
+	}
+
+	for _, dataType := range inferOrder {
+		matches := true
+		for _, v := range values {
+			if !cleaner.MatchesType(dataType, v) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return dataType
+		}
+	}
+	return TypeText
+}
+
+// topValues returns the n most frequent values, ties broken alphabetically
+func topValues(counts map[string]int, n int) []cleaner.ValueCount {
+	top := make([]cleaner.ValueCount, 0, len(counts))
+	for value, count := range counts {
+		top = append(top, cleaner.ValueCount{Value: value, Count: count})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Value < top[j].Value
+	})
+	return top[:min(n, len(top))]
+}
+
+// numericStats summarizes values already known to be numbers
+func numericStats(values []string) *NumericStats {
+	numbers := make([]float64, len(values))
+	total := 0.0
+	for i, v := range values {
+		numbers[i], _ = strconv.ParseFloat(strings.TrimSpace(v), 64)
+		total += numbers[i]
+	}
+	sort.Float64s(numbers)
+
+	stats := &NumericStats{
+		Min:  numbers[0],
+		Max:  numbers[len(numbers)-1],
+		Mean: total / float64(len(numbers)),
+	}
+
+	mid := len(numbers) / 2
+	stats.Median = numbers[mid]
+	if len(numbers)%2 == 0 {
+		stats.Median = (numbers[mid-1] + numbers[mid]) / 2
+	}
+
+	variance := 0.0
+	for _, n := range numbers {
+		variance += (n - stats.Mean) * (n - stats.Mean)
+	}
+	stats.Std = math.Sqrt(variance / float64(len(numbers)))
+
+	return stats
+}
@@ -0,0 +1,84 @@
+package profiler
+
+import (
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func TestInferType(t *testing.T) {
+	tests := []struct {
+		values   []string
+		expected string
+	}{
+		{nil, TypeEmpty},
+		{[]string{"1", "-20", "300"}, TypeInteger},
+		{[]string{"1", "2.5"}, TypeNumber},
+		{[]string{"2024-01-05", "05.02.2024"}, TypeDate},
+		{[]string{"yes", "no", "true"}, TypeBool},
+		{[]string{"1", "abc"}, TypeText},
+		{[]string{"1", "NaN"}, TypeText},
+		{[]string{"2.5", "-Inf", "Infinity"}, TypeText},
+	}
+
+	for _, tt := range tests {
+		if got := InferType(tt.values); got != tt.expected {
+			t.Errorf("InferType(%v): expected %s, got %s", tt.values, tt.expected, got)
+		}
+	}
+}
+
+func TestProfile(t *testing.T) {
+	dt := models.NewDataTable([]string{"ID", "City", "Empty"})
+	dt.AddRow([]string{"1", "Ankara", ""})
+	dt.AddRow([]string{"2", "N/A", ""})
+	dt.AddRow([]string{"3", "Ankara", ""})
+	dt.Nulls = models.DefaultNullMarkers()
+
+	profiles := Profile(dt)
+	if len(profiles) != 3 {
+		t.Fatalf("Expected 3 profiles, got %d", len(profiles))
+	}
+
+	city := profiles[1]
+	if city.Type != TypeText || city.Count != 2 || city.Missing != 1 || city.Distinct != 1 {
+		t.Errorf("Unexpected City profile: %+v", city)
+	}
+	if profiles[0].Type != TypeInteger || profiles[2].Type != TypeEmpty {
+		t.Errorf("Unexpected types: %s, %s", profiles[0].Type, profiles[2].Type)
+	}
+}
+
+func TestProfileStatistics(t *testing.T) {
+	dt := models.NewDataTable([]string{"Price", "Code"})
+	for _, row := range [][]string{{"10", "ab"}, {"20", "abcd"}, {"30", "ab"}, {"40", "x"}} {
+		dt.AddRow(row)
+	}
+
+	price := ProfileColumn(dt, 0)
+	if price.Numeric == nil {
+		t.Fatal("Expected numeric stats for Price")
+	}
+	want := NumericStats{Min: 10, Max: 40, Mean: 25, Median: 25}
+	got := *price.Numeric
+	if got.Min != want.Min || got.Max != want.Max || got.Mean != want.Mean || got.Median != want.Median {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+	if got.Std < 11.18 || got.Std > 11.19 {
+		t.Errorf("Expected std ~11.18, got %f", got.Std)
+	}
+
+	code := ProfileColumn(dt, 1)
+	if code.Numeric != nil {
+		t.Error("Expected no numeric stats for text column")
+	}
+	if code.MinLength != 1 || code.MaxLength != 4 {
+		t.Errorf("Expected lengths 1..4, got %d..%d", code.MinLength, code.MaxLength)
+	}
+	if len(code.TopValues) != 3 || code.TopValues[0].Value != "ab" || code.TopValues[0].Count != 2 {
+		t.Errorf("Unexpected top values: %v", code.TopValues)
+	}
+	if len(code.Samples) != 3 || code.Samples[1] != "abcd" {
+		t.Errorf("Unexpected samples: %v", code.Samples)
+	}
+}
@@ -0,0 +1,393 @@
+package recipe
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/file"
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/reshaper"
+)
+
+// Operation names used in recipe files
+const (
+	OpClean            = "clean"
+	OpStandardizeNulls = "standardize_nulls"
+	OpFill             = "fill"
+	OpNormalizeDates   = "normalize_dates"
+	OpNormalizeNumbers = "normalize_numbers"
+	OpReplace          = "replace"
+	OpSplitColumn      = "split_column"
+	OpMergeColumns     = "merge_columns"
+	OpComputeColumn    = "compute_column"
+	OpFilterRows       = "filter_rows"
+	OpStandardizeText  = "standardize_text"
+	OpMapValues        = "map_values"
+	OpHandleOutliers   = "handle_outliers"
+	OpJoin             = "join"
+	OpAppend           = "append"
+	OpSortRows         = "sort_rows"
+	OpDeleteColumns    = "delete_columns"
+	OpKeepColumns      = "keep_columns"
+	OpRenameColumn     = "rename_column"
+	OpDuplicateColumn  = "duplicate_column"
+	OpMoveColumn       = "move_column"
+	OpSwapColumns      = "swap_columns"
+	OpEditCell         = "edit_cell"
+	OpInsertRow        = "insert_row"
+	OpDeleteRows       = "delete_rows"
+	OpSetNullMarkers   = "set_null_markers"
+)
+
+// CleanParams are the parameters of an OpClean step
+type CleanParams struct {
+	models.CleanOptions
+	Nulls *models.NullMarkers `json:"nulls,omitempty"` // Markers the step was applied with (defaults to the table's own)
+}
+
+// DateParams are the parameters of an OpNormalizeDates step
+type DateParams struct {
+	Column string `json:"column"`
+	cleaner.DateOptions
+}
+
+// NumberParams are the parameters of an OpNormalizeNumbers step
+type NumberParams struct {
+	Column string `json:"column"`
+	cleaner.NumberOptions
+}
+
+// OutlierParams are the parameters of an OpHandleOutliers step
+type OutlierParams struct {
+	cleaner.OutlierOptions
+	Action cleaner.OutlierAction `json:"action"`
+}
+
+// JoinParams are the parameters of an OpJoin step
+type JoinParams struct {
+	File string `json:"file"` // Path of the right table
+	reshaper.JoinOptions
+}
+
+// AppendParams are the parameters of an OpAppend step
+type AppendParams struct {
+	Files []string `json:"files"` // Paths or glob patterns of the files to append
+	reshaper.AppendOptions
+}
+
+// ColumnsParams are the parameters of OpDeleteColumns and OpKeepColumns steps
+type ColumnsParams struct {
+	Columns []string `json:"columns"`
+}
+
+// RenameParams are the parameters of OpRenameColumn and OpDuplicateColumn steps
+type RenameParams struct {
+	Column string `json:"column"`
+	Name   string `json:"name"` // New header (the copy's header for OpDuplicateColumn)
+}
+
+// MoveParams are the parameters of an OpMoveColumn step
+type MoveParams struct {
+	Column   string `json:"column"`
+	Position int    `json:"position"` // 0-based target position
+}
+
+// SwapParams are the parameters of an OpSwapColumns step
+type SwapParams struct {
+	Column string `json:"column"`
+	Other  string `json:"other"`
+}
+
+// InsertRowParams are the parameters of an OpInsertRow step
+type InsertRowParams struct {
+	Row    int      `json:"row"`              // 0-based position of the new row
+	Values []string `json:"values,omitempty"` // Cell values, missing ones are left empty
+}
+
+// RowsParams are the parameters of an OpDeleteRows step
+type RowsParams struct {
+	Rows []int `json:"rows"` // 0-based row indexes
+}
+
+// manualOps are the operations recording hand edits of single cells or rows
+// They refer to row positions, so they only replay on the same data
+var manualOps = map[string]bool{
+	OpEditCell:   true,
+	OpInsertRow:  true,
+	OpDeleteRows: true,
+}
+
+// IsManual reports whether an operation is a hand edit rather than a rule-based step
+func IsManual(op string) bool {
+	return manualOps[op]
+}
+
+// NullMarkersParams are the parameters of an OpSetNullMarkers step
+type NullMarkersParams struct {
+	Column  string   `json:"column"`
+	Markers []string `json:"markers"` // Extra markers of the column, empty removes them
+}
+
+// NullParams are the parameters of an OpStandardizeNulls step
+type NullParams struct {
+	Markers        *models.NullMarkers `json:"markers,omitempty"` // Markers to use (defaults to the table's own)
+	Representation string              `json:"representation"`
+}
+
+// operations maps operation names to their implementation
+var operations = map[string]Operation{
+	OpClean: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p CleanParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		if p.Nulls != nil {
+			dt = dt.Clone()
+			dt.Nulls = p.Nulls
+		}
+		return cleaner.ApplyCleaningOptions(dt, p.CleanOptions), nil
+	},
+
+	OpSetNullMarkers: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p NullMarkersParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		return cleaner.SetNullMarkers(dt, p.Column, p.Markers)
+	},
+
+	OpStandardizeNulls: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p NullParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		if p.Markers != nil {
+			dt = dt.Clone()
+			dt.Nulls = p.Markers
+		}
+		return cleaner.StandardizeNulls(dt, p.Representation), nil
+	},
+
+	OpFill: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var rules []cleaner.FillRule
+		if err := decode(params, &rules); err != nil {
+			return nil, err
+		}
+		result, err := cleaner.FillMissing(dt, rules)
+		return result.Table, err
+	},
+
+	OpNormalizeDates: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p DateParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		result, err := cleaner.NormalizeDates(dt, p.Column, p.DateOptions)
+		return result.Table, err
+	},
+
+	OpNormalizeNumbers: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p NumberParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		result, err := cleaner.NormalizeNumbers(dt, p.Column, p.NumberOptions)
+		return result.Table, err
+	},
+
+	OpReplace: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var opts cleaner.ReplaceOptions
+		if err := decode(params, &opts); err != nil {
+			return nil, err
+		}
+		result, _, err := cleaner.FindReplace(dt, opts)
+		return result, err
+	},
+
+	OpSplitColumn: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var opts cleaner.SplitOptions
+		if err := decode(params, &opts); err != nil {
+			return nil, err
+		}
+		return cleaner.SplitColumn(dt, opts)
+	},
+
+	OpMergeColumns: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var opts cleaner.MergeOptions
+		if err := decode(params, &opts); err != nil {
+			return nil, err
+		}
+		return cleaner.MergeColumns(dt, opts)
+	},
+
+	OpComputeColumn: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var opts cleaner.ComputeOptions
+		if err := decode(params, &opts); err != nil {
+			return nil, err
+		}
+		result, err := cleaner.ComputeColumn(dt, opts)
+		return result.Table, err
+	},
+
+	OpFilterRows: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var f cleaner.Filter
+		if err := decode(params, &f); err != nil {
+			return nil, err
+		}
+		result, _, err := cleaner.FilterRows(dt, f)
+		return result, err
+	},
+
+	OpStandardizeText: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var opts cleaner.TextOptions
+		if err := decode(params, &opts); err != nil {
+			return nil, err
+		}
+		result, _, err := cleaner.StandardizeText(dt, opts)
+		return result, err
+	},
+
+	OpMapValues: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var mapping cleaner.ValueMapping
+		if err := decode(params, &mapping); err != nil {
+			return nil, err
+		}
+		result, _, err := cleaner.MapValues(dt, mapping)
+		return result, err
+	},
+
+	OpHandleOutliers: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p OutlierParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		result, _, err := cleaner.HandleOutliers(dt, p.OutlierOptions, p.Action)
+		return result, err
+	},
+
+	OpSortRows: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var keys []cleaner.SortKey
+		if err := decode(params, &keys); err != nil {
+			return nil, err
+		}
+		return cleaner.SortRows(dt, keys)
+	},
+
+	OpDeleteColumns: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p ColumnsParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		return cleaner.DeleteColumns(dt, p.Columns)
+	},
+
+	OpKeepColumns: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p ColumnsParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		return cleaner.KeepColumns(dt, p.Columns)
+	},
+
+	OpRenameColumn: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p RenameParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		return cleaner.RenameColumn(dt, p.Column, p.Name)
+	},
+
+	OpDuplicateColumn: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p RenameParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		return cleaner.DuplicateColumn(dt, p.Column, p.Name)
+	},
+
+	OpMoveColumn: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p MoveParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		return cleaner.MoveColumn(dt, p.Column, p.Position)
+	},
+
+	OpSwapColumns: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p SwapParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		return cleaner.SwapColumns(dt, p.Column, p.Other)
+	},
+
+	OpEditCell: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var edit cleaner.CellEdit
+		if err := decode(params, &edit); err != nil {
+			return nil, err
+		}
+		return cleaner.EditCell(dt, edit)
+	},
+
+	OpInsertRow: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p InsertRowParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		return cleaner.InsertRow(dt, p.Row, p.Values)
+	},
+
+	OpDeleteRows: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p RowsParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		return cleaner.DeleteRows(dt, p.Rows)
+	},
+
+	OpJoin: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p JoinParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		right, err := file.LoadFile(p.File)
+		if err != nil {
+			return nil, err
+		}
+		right.Nulls = dt.Nulls.ForOtherTable()
+		result, err := reshaper.Join(dt, right, p.JoinOptions)
+		return result.Table, err
+	},
+
+	OpAppend: func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error) {
+		var p AppendParams
+		if err := decode(params, &p); err != nil {
+			return nil, err
+		}
+		result, err := reshaper.AppendFiles(dt, p.Files, p.AppendOptions)
+		return result.Table, err
+	},
+}
+
+// Operations returns the names of all supported operations, sorted
+func Operations() []string {
+	names := make([]string, 0, len(operations))
+	for name := range operations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// decode unmarshals step parameters into v
+func decode(params json.RawMessage, v any) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return fmt.Errorf("invalid parameters: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package recipe
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// Step is one recorded operation of a recipe
+type Step struct {
+	Op     string          `json:"op"`               // Operation name, see Operations
+	Params json.RawMessage `json:"params,omitempty"` // Operation specific parameters
+}
+
+// Recipe is an ordered list of cleaning steps that can be saved and replayed
+type Recipe struct {
+	Name  string `json:"name,omitempty"`
+	Steps []Step `json:"steps"`
+}
+
+// Operation applies one step to a table using its raw parameters
+type Operation func(dt *models.DataTable, params json.RawMessage) (*models.DataTable, error)
+
+// NewStep creates a step with the given parameters encoded as JSON
+func NewStep(op string, params any) (Step, error) {
+	if _, ok := operations[op]; !ok {
+		return Step{}, fmt.Errorf("unknown recipe operation %q", op)
+	}
+
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return Step{}, fmt.Errorf("failed to encode %s parameters: %w", op, err)
+	}
+
+	return Step{Op: op, Params: raw}, nil
+}
+
+// Add appends a new step to the recipe
+func (r *Recipe) Add(op string, params any) error {
+	step, err := NewStep(op, params)
+	if err != nil {
+		return err
+	}
+
+	r.Steps = append(r.Steps, step)
+	return nil
+}
+
+// Apply runs every step in order on a copy of the table
+func (r *Recipe) Apply(dt *models.DataTable) (*models.DataTable, error) {
+	if dt == nil {
+		return nil, fmt.Errorf("no data to apply recipe to")
+	}
+
+	result := dt
+	for i, step := range r.Steps {
+		op, ok := operations[step.Op]
+		if !ok {
+			return nil, fmt.Errorf("step %d: unknown operation %q", i+1, step.Op)
+		}
+
+		next, err := op(result, step.Params)
+		if err != nil {
+			return nil, fmt.Errorf("step %d (%s): %w", i+1, step.Op, err)
+		}
+		result = next
+	}
+
+	return result, nil
+}
+
+// Load reads a recipe from a JSON file
+func Load(path string) (*Recipe, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recipe: %w", err)
+	}
+
+	var r Recipe
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("failed to parse recipe: %w", err)
+	}
+
+	return &r, nil
+}
+
+// Save writes the recipe to a JSON file
+func (r *Recipe) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode recipe: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write recipe: %w", err)
+	}
+
+	return nil
+}
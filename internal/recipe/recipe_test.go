@@ -0,0 +1,159 @@
+package recipe
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func TestRecipeApply(t *testing.T) {
+	dt := models.NewDataTable([]string{"Name", "City"})
+	dt.AddRow([]string{"John Smith", "NYC"})
+	dt.AddRow([]string{"Jane Doe", ""})
+
+	var r Recipe
+	if err := r.Add(OpSplitColumn, cleaner.SplitOptions{
+		Column:     "Name",
+		Mode:       cleaner.SplitByDelimiter,
+		Delimiter:  " ",
+		NewColumns: []string{"First", "Last"},
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := r.Add(OpFill, []cleaner.FillRule{{Column: "City", Strategy: cleaner.FillConstant, Value: "?"}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := r.Add(OpMergeColumns, cleaner.MergeOptions{
+		Columns:   []string{"Last", "First"},
+		Separator: ", ",
+		NewColumn: "Name",
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got, err := r.Apply(dt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := [][]string{{"Smith, John", "NYC"}, {"Doe, Jane", "?"}}
+	if !reflect.DeepEqual(got.Rows, want) {
+		t.Errorf("want %v, got %v", want, got.Rows)
+	}
+
+	if dt.Rows[0][0] != "John Smith" {
+		t.Error("Recipe modified the input table")
+	}
+}
+
+func TestRecipeCleanUsesRecordedNulls(t *testing.T) {
+	dt := models.NewDataTable([]string{"Region"})
+	dt.AddRow([]string{"NA"})
+	dt.AddRow([]string{"n/a"})
+
+	var r Recipe
+	opts := models.CleanOptions{StandardizeNulls: true}
+	if err := r.Add(OpClean, CleanParams{CleanOptions: opts, Nulls: &models.NullMarkers{Global: []string{"N/A"}}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got, err := r.Apply(dt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := [][]string{{"NA"}, {""}}
+	if !reflect.DeepEqual(got.Rows, want) {
+		t.Errorf("want %v, got %v", want, got.Rows)
+	}
+}
+
+func TestRecipeCleanPerColumnNullsBeforeHeaders(t *testing.T) {
+	dt := models.NewDataTable([]string{"Zip Code"})
+	dt.AddRow([]string{"00000"})
+	dt.AddRow([]string{"34000"})
+
+	// Markers are recorded as they were before cleaning, under the original header
+	nulls := &models.NullMarkers{}
+	nulls.SetColumn("Zip Code", []string{"00000"})
+
+	var r Recipe
+	opts := models.CleanOptions{StandardizeNulls: true, NormalizeHeaders: true}
+	if err := r.Add(OpClean, CleanParams{CleanOptions: opts, Nulls: nulls}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got, err := r.Apply(dt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := [][]string{{""}, {"34000"}}; !reflect.DeepEqual(got.Rows, want) || got.Headers[0] != "zip_code" {
+		t.Errorf("want %v under zip_code, got %v under %v", want, got.Rows, got.Headers)
+	}
+}
+
+func TestRecipeSaveLoad(t *testing.T) {
+	r := Recipe{Name: "test"}
+	r.Add(OpReplace, cleaner.ReplaceOptions{Find: "a", Replace: "b"})
+
+	path := filepath.Join(t.TempDir(), "recipe.json")
+	if err := r.Save(path); err != nil {
+		t.Fatalf("Failed to save: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Failed to load: %v", err)
+	}
+
+	if loaded.Name != "test" || len(loaded.Steps) != 1 || loaded.Steps[0].Op != OpReplace {
+		t.Errorf("Unexpected recipe: %+v", loaded)
+	}
+}
+
+func TestRecipeErrors(t *testing.T) {
+	if _, err := NewStep("bogus", nil); err == nil {
+		t.Error("Expected error for unknown operation")
+	}
+
+	r := Recipe{Steps: []Step{{Op: OpSplitColumn, Params: []byte(`{"column":"Missing","mode":"delimiter","delimiter":","}`)}}}
+	if _, err := r.Apply(models.NewDataTable([]string{"A"})); err == nil {
+		t.Error("Expected error for failing step")
+	}
+}
+
+func TestRecipeManualEdits(t *testing.T) {
+	dt := models.NewDataTable([]string{"Name", "City"})
+	dt.AddRow([]string{"Ali", "Izmr"})
+	dt.AddRow([]string{"test", "test"})
+
+	var r Recipe
+	r.Add(OpEditCell, cleaner.CellEdit{Row: 0, Column: "City", Old: "Izmr", Value: "Izmir"})
+	r.Add(OpDeleteRows, RowsParams{Rows: []int{1}})
+	r.Add(OpInsertRow, InsertRowParams{Row: 1, Values: []string{"Can", "Bursa"}})
+
+	got, err := r.Apply(dt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := [][]string{{"Ali", "Izmir"}, {"Can", "Bursa"}}
+	if !reflect.DeepEqual(got.Rows, want) {
+		t.Errorf("want %v, got %v", want, got.Rows)
+	}
+
+	for _, step := range r.Steps {
+		if !IsManual(step.Op) {
+			t.Errorf("Expected %s to be a manual operation", step.Op)
+		}
+	}
+	if IsManual(OpFill) {
+		t.Error("Expected fill not to be a manual operation")
+	}
+
+	// Replaying the cell edit on different data fails instead of overwriting it
+	if _, err := r.Apply(got); err == nil {
+		t.Error("Expected error when the edited cell holds a different value")
+	}
+}
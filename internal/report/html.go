@@ -0,0 +1,66 @@
+package report
+
+import (
+	"html/template"
+	"io"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+)
+
+// htmlTemplate is a self-contained page: styles are inline, nothing is loaded from the network
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"location": issueLocation,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Data Quality Report: {{.Report.File}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem; color: #222; }
+h1 { color: #5f5fff; }
+table { border-collapse: collapse; margin-bottom: 2rem; }
+th, td { border: 1px solid #ddd; padding: 0.3rem 0.7rem; text-align: left; }
+th { background: #5f5fff; color: #fff; }
+td.num { text-align: right; }
+tr.error td { background: #ffecec; }
+tr.warning td { background: #fff8e1; }
+.muted { color: #888; }
+</style>
+</head>
+<body>
+<h1>Data Quality Report: {{.Report.File}}</h1>
+<p class="muted">Generated {{.Report.GeneratedAt.Format "2006-01-02 15:04"}}</p>
+
+<h2>Summary</h2>
+<table>
+<tr><th>Check</th><th>Count</th></tr>
+{{range .Summary}}<tr><td>{{index . 0}}</td><td class="num">{{index . 1}}</td></tr>
+{{end}}</table>
+
+<h2>Columns</h2>
+<table>
+<tr><th>Column</th><th>Type</th><th>Values</th><th>Missing</th><th>Distinct</th></tr>
+{{range .Report.Profiles}}<tr><td>{{.Name}}</td><td>{{.Type}}</td><td class="num">{{.Count}}</td><td class="num">{{.Missing}}</td><td class="num">{{.Distinct}}</td></tr>
+{{end}}</table>
+
+<h2>Issues ({{len .Report.Issues}})</h2>
+{{if .Issues}}<table>
+<tr><th>Location</th><th>Severity</th><th>Kind</th><th>Message</th></tr>
+{{range .Issues}}<tr class="{{.Severity}}"><td>{{location .}}</td><td>{{.Severity}}</td><td>{{.Kind}}</td><td>{{.Message}}</td></tr>
+{{end}}</table>
+{{if .Omitted}}<p class="muted">{{.Omitted}} more issues not listed, see the JSON report.</p>{{end}}
+{{else}}<p>No issues found.</p>{{end}}
+</body>
+</html>
+`))
+
+// WriteHTML renders the report as a self-contained HTML page
+func (r Report) WriteHTML(w io.Writer) error {
+	issues, omitted := r.listedIssues()
+	return htmlTemplate.Execute(w, struct {
+		Report  Report
+		Summary [][2]string
+		Issues  []cleaner.Issue
+		Omitted int
+	}{r, r.summary(), issues, omitted})
+}
@@ -0,0 +1,49 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteMarkdown renders the report as a Markdown document
+func (r Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Data Quality Report: %s\n\n", mdEscape(r.File))
+	fmt.Fprintf(&b, "Generated %s\n\n", r.GeneratedAt.Format("2006-01-02 15:04"))
+
+	b.WriteString("## Summary\n\n| Check | Count |\n|---|---:|\n")
+	for _, row := range r.summary() {
+		fmt.Fprintf(&b, "| %s | %s |\n", row[0], row[1])
+	}
+
+	b.WriteString("\n## Columns\n\n| Column | Type | Values | Missing | Distinct |\n|---|---|---:|---:|---:|\n")
+	for _, p := range r.Profiles {
+		fmt.Fprintf(&b, "| %s | %s | %d | %d | %d |\n", mdEscape(p.Name), p.Type, p.Count, p.Missing, p.Distinct)
+	}
+
+	issues, omitted := r.listedIssues()
+	fmt.Fprintf(&b, "\n## Issues (%d)\n\n", len(r.Issues))
+	if len(issues) == 0 {
+		b.WriteString("No issues found.\n")
+	} else {
+		b.WriteString("| Location | Severity | Kind | Message |\n|---|---|---|---|\n")
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n",
+				mdEscape(issueLocation(issue)), issue.Severity, issue.Kind, mdEscape(issue.Message))
+		}
+		if omitted > 0 {
+			fmt.Fprintf(&b, "\n%d more issues not listed, see the JSON report.\n", omitted)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// mdEscape keeps cell text from breaking a Markdown table
+func mdEscape(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -0,0 +1,154 @@
+// Package report renders data quality results as HTML, Markdown or JSON.
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/profiler"
+)
+
+// Format is an output format of a report
+type Format string
+
+const (
+	FormatHTML     Format = "html"
+	FormatMarkdown Format = "md"
+	FormatJSON     Format = "json"
+)
+
+// maxListedIssues caps the issues listed in HTML and Markdown reports (JSON lists all)
+const maxListedIssues = 1000
+
+// Report is the result of a QA run on one table
+type Report struct {
+	File        string                   `json:"file"`
+	GeneratedAt time.Time                `json:"generated_at"`
+	Rows        int                      `json:"rows"`
+	Columns     int                      `json:"columns"`
+	Validation  cleaner.ValidationResult `json:"validation"`
+	Profiles    []profiler.ColumnProfile `json:"profiles"`
+	Violations  []cleaner.Violation      `json:"-"`      // Written to JSON as rule issues in Issues
+	Issues      []cleaner.Issue          `json:"issues"` // Validation issues and rule violations, sorted by position
+}
+
+// New validates the table against the rules and collects the results
+func New(dt *models.DataTable, rules []cleaner.Rule) (Report, error) {
+	if dt == nil {
+		return Report{}, fmt.Errorf("no data loaded")
+	}
+
+	violations, err := cleaner.ValidateRules(dt, rules)
+	if err != nil {
+		return Report{}, err
+	}
+
+	r := Report{
+		File:        dt.FileName,
+		GeneratedAt: time.Now(),
+		Rows:        dt.RowCount(),
+		Columns:     dt.ColumnCount(),
+		Validation:  cleaner.ValidateData(dt),
+		Profiles:    profiler.Profile(dt),
+		Violations:  violations,
+	}
+
+	r.Issues = append(append([]cleaner.Issue(nil), r.Validation.Issues...), cleaner.RuleIssues(violations)...)
+	cleaner.SortIssues(dt, r.Issues)
+
+	return r, nil
+}
+
+// FormatFromPath picks the format from a file extension
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".html", ".htm":
+		return FormatHTML, nil
+	case ".md", ".markdown":
+		return FormatMarkdown, nil
+	case ".json":
+		return FormatJSON, nil
+	}
+	return "", fmt.Errorf("unsupported report format: %s (supported: .html, .md, .json)", filepath.Ext(path))
+}
+
+// Write renders the report in the given format
+func (r Report) Write(w io.Writer, format Format) error {
+	switch format {
+	case FormatHTML:
+		return r.WriteHTML(w)
+	case FormatMarkdown:
+		return r.WriteMarkdown(w)
+	case FormatJSON:
+		return r.WriteJSON(w)
+	}
+	return fmt.Errorf("unknown report format %q", format)
+}
+
+// Save writes the report to a file, picking the format from its extension
+func (r Report) Save(path string) error {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create report: %w", err)
+	}
+	defer f.Close()
+
+	if err := r.Write(f, format); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return f.Close()
+}
+
+// WriteJSON renders the report as indented JSON
+func (r Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// summary returns the headline counts shown in every format
+func (r Report) summary() [][2]string {
+	v := r.Validation
+	return [][2]string{
+		{"Rows", fmt.Sprint(r.Rows)},
+		{"Columns", fmt.Sprint(r.Columns)},
+		{"Duplicate rows", fmt.Sprint(v.DuplicateCount)},
+		{"Empty rows", fmt.Sprint(v.EmptyRowCount)},
+		{"Empty columns", fmt.Sprint(v.EmptyColumnCount)},
+		{"Missing values", fmt.Sprint(v.MissingValueCount)},
+		{"Outliers (IQR)", fmt.Sprint(v.OutlierCount)},
+		{"Rule violations", fmt.Sprint(len(r.Violations))},
+	}
+}
+
+// listedIssues returns the issues shown in HTML and Markdown and how many were left out
+func (r Report) listedIssues() ([]cleaner.Issue, int) {
+	if len(r.Issues) <= maxListedIssues {
+		return r.Issues, 0
+	}
+	return r.Issues[:maxListedIssues], len(r.Issues) - maxListedIssues
+}
+
+// issueLocation formats where an issue is, using 1-based row numbers
+func issueLocation(issue cleaner.Issue) string {
+	if issue.Row < 0 {
+		return "column " + issue.Column
+	}
+	location := fmt.Sprintf("row %d", issue.Row+1)
+	if issue.Column != "" {
+		location += ", " + issue.Column
+	}
+	return location
+}
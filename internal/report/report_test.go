@@ -0,0 +1,120 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func sampleReport(t *testing.T) Report {
+	t.Helper()
+
+	dt := models.NewDataTable([]string{"Name", "Age"})
+	dt.FileName = "<b>people</b>.csv"
+	dt.AddRow([]string{"John", "30"})
+	dt.AddRow([]string{"Jane", ""})
+	dt.AddRow([]string{"Ali|Veli", "-4"})
+
+	zero := 0.0
+	r, err := New(dt, []cleaner.Rule{{Column: "Age", Type: cleaner.RuleMin, Min: &zero}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return r
+}
+
+func TestNew(t *testing.T) {
+	r := sampleReport(t)
+
+	if r.Rows != 3 || r.Columns != 2 || len(r.Profiles) != 2 {
+		t.Errorf("Unexpected report shape: %+v", r)
+	}
+	if len(r.Violations) != 1 || len(r.Issues) != 2 {
+		t.Fatalf("Expected 1 violation and 2 issues, got %v and %v", r.Violations, r.Issues)
+	}
+	if r.Issues[0].Kind != cleaner.IssueMissing || r.Issues[1].Kind != cleaner.IssueRule {
+		t.Errorf("Expected issues sorted by row, got %v", r.Issues)
+	}
+}
+
+func TestWriteFormats(t *testing.T) {
+	r := sampleReport(t)
+
+	var html bytes.Buffer
+	if err := r.WriteHTML(&html); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Contains(html.String(), "<b>people") {
+		t.Error("Expected HTML to escape the file name")
+	}
+	if !strings.Contains(html.String(), "row 3, Age") {
+		t.Error("Expected HTML to list the rule violation")
+	}
+
+	var md bytes.Buffer
+	if err := r.WriteMarkdown(&md); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(md.String(), "| Missing values | 1 |") {
+		t.Errorf("Expected summary row in Markdown, got:\n%s", md.String())
+	}
+
+	var js bytes.Buffer
+	if err := r.WriteJSON(&js); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(js.Bytes(), &decoded); err != nil {
+		t.Fatalf("Expected valid JSON: %v", err)
+	}
+	if decoded.Validation.MissingValueCount != 1 || len(decoded.Issues) != 2 {
+		t.Errorf("Unexpected decoded report: %+v", decoded)
+	}
+	if n := strings.Count(js.String(), `"issues"`); n != 1 {
+		t.Errorf("Expected issues written once, got %d lists", n)
+	}
+	if n := strings.Count(js.String(), `"kind": "rule"`); n != 1 || strings.Contains(js.String(), `"violations"`) {
+		t.Errorf("Expected the rule violation written once, got %d rule issues in:\n%s", n, js.String())
+	}
+}
+
+func TestWriteJSONNonFiniteValues(t *testing.T) {
+	dt := models.NewDataTable([]string{"Score"})
+	for _, v := range []string{"1", "NaN", "Inf", "2"} {
+		dt.AddRow([]string{v})
+	}
+
+	r, err := New(dt, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var js bytes.Buffer
+	if err := r.WriteJSON(&js); err != nil {
+		t.Errorf("Expected NaN and Inf read as text, got %v", err)
+	}
+}
+
+func TestSave(t *testing.T) {
+	r := sampleReport(t)
+	dir := t.TempDir()
+
+	for _, name := range []string{"report.html", "report.md", "report.json"} {
+		path := filepath.Join(dir, name)
+		if err := r.Save(path); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if info, err := os.Stat(path); err != nil || info.Size() == 0 {
+			t.Errorf("%s: expected a non-empty file", name)
+		}
+	}
+
+	if err := r.Save(filepath.Join(dir, "report.pdf")); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
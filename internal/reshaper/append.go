@@ -0,0 +1,240 @@
+package reshaper
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/veliulugut/snapclean/internal/file"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// AppendOptions configures stacking tables on top of each other
+type AppendOptions struct {
+	MatchNormalized bool   `json:"match_normalized,omitempty"` // Match headers ignoring case, spaces, punctuation and Turkish letters
+	SourceColumn    string `json:"source_column,omitempty"`    // Name of a column holding the source file name (empty for none)
+}
+
+// SchemaMismatch lists how the headers of one file differ from the first file
+type SchemaMismatch struct {
+	File    string   `json:"file"`
+	Missing []string `json:"missing,omitempty"` // Columns of the first file this file lacks (filled with empty cells)
+	Extra   []string `json:"extra,omitempty"`   // Columns this file adds
+}
+
+// AppendResult holds the combined table and the files whose headers differ
+type AppendResult struct {
+	Table      *models.DataTable `json:"-"`
+	Files      []string          `json:"files"`
+	Mismatches []SchemaMismatch  `json:"mismatches,omitempty"`
+}
+
+// Append stacks the rows of several tables, aligning columns by header
+// The first table defines the column order; columns that only later tables
+// have are added at the end and left empty for the other tables
+func Append(tables []*models.DataTable, opts AppendOptions) (AppendResult, error) {
+	if len(tables) == 0 {
+		return AppendResult{}, fmt.Errorf("no tables to append")
+	}
+
+	key := func(header string) string { return header }
+	if opts.MatchNormalized {
+		key = headerKey
+	}
+
+	type column struct {
+		header string
+		key    string
+	}
+	var columns []column
+	var result AppendResult
+	mappings := make([][]int, len(tables))
+
+	for t, dt := range tables {
+		if dt == nil {
+			return AppendResult{}, fmt.Errorf("table %d is empty", t+1)
+		}
+		result.Files = append(result.Files, dt.FileName)
+
+		// Map each column to the first unused output column with the same key
+		used := make(map[int]bool, len(dt.Headers))
+		mappings[t] = make([]int, len(dt.Headers))
+		var extra []string
+		for colIdx, header := range dt.Headers {
+			k := key(header)
+			target := -1
+			for i, c := range columns {
+				if c.key == k && !used[i] {
+					target = i
+					break
+				}
+			}
+			if target == -1 {
+				target = len(columns)
+				columns = append(columns, column{header: header, key: k})
+				if t > 0 {
+					extra = append(extra, header)
+				}
+			}
+			used[target] = true
+			mappings[t][colIdx] = target
+		}
+
+		if t == 0 {
+			continue
+		}
+		var missing []string
+		for i := range tables[0].Headers {
+			if !used[i] {
+				missing = append(missing, columns[i].header)
+			}
+		}
+		if len(missing) > 0 || len(extra) > 0 {
+			result.Mismatches = append(result.Mismatches, SchemaMismatch{File: dt.FileName, Missing: missing, Extra: extra})
+		}
+	}
+
+	headers := make([]string, len(columns))
+	taken := make(map[string]bool, len(columns)+1)
+	for i, c := range columns {
+		headers[i] = c.header
+		taken[c.header] = true
+	}
+	sourceCol := -1
+	if opts.SourceColumn != "" {
+		sourceCol = len(headers)
+		headers = append(headers, uniqueHeader(opts.SourceColumn, "_", taken))
+	}
+
+	first := tables[0]
+	result.Table = &models.DataTable{
+		Headers:  headers,
+		Rows:     make([][]string, 0),
+		FilePath: first.FilePath,
+		FileName: first.FileName,
+		Nulls:    first.Nulls.Clone(),
+	}
+	for t, dt := range tables {
+		for _, row := range dt.Rows {
+			out := make([]string, len(headers))
+			for colIdx, target := range mappings[t] {
+				out[target] = cell(row, colIdx)
+			}
+			if sourceCol != -1 {
+				out[sourceCol] = dt.FileName
+			}
+			result.Table.Rows = append(result.Table.Rows, out)
+		}
+	}
+
+	return result, nil
+}
+
+// ExpandFiles resolves file paths and glob patterns to a sorted, de-duplicated file list
+func ExpandFiles(patterns []string) ([]string, error) {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files match %q", pattern)
+		}
+		sort.Strings(matches)
+
+		for _, path := range matches {
+			if !seen[path] {
+				seen[path] = true
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no files given")
+	}
+	return paths, nil
+}
+
+// AppendFiles loads files matching the patterns and appends them to dt
+// dt may be nil to combine the files alone; its own file is never appended twice
+func AppendFiles(dt *models.DataTable, patterns []string, opts AppendOptions) (AppendResult, error) {
+	tables, err := LoadAppendFiles(dt, patterns)
+	if err != nil {
+		return AppendResult{}, err
+	}
+	if dt != nil {
+		tables = append([]*models.DataTable{dt}, tables...)
+	}
+	return Append(tables, opts)
+}
+
+// LoadAppendFiles loads the files matching the patterns to append to dt, leaving out
+// the files of dt and of the already open tables appended with it
+// The files are read with dt's default and global null markers
+func LoadAppendFiles(dt *models.DataTable, patterns []string, open ...*models.DataTable) ([]*models.DataTable, error) {
+	paths, err := ExpandFiles(patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := append([]*models.DataTable{dt}, open...)
+	var tables []*models.DataTable
+	for _, path := range paths {
+		if slices.ContainsFunc(skip, func(t *models.DataTable) bool {
+			return t != nil && t.FilePath != "" && samePath(t.FilePath, path)
+		}) {
+			continue
+		}
+
+		table, err := file.LoadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if dt != nil {
+			table.Nulls = dt.Nulls.ForOtherTable()
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// headerKey normalizes a header for matching: "Müşteri Adı" and "musteri_adi" are equal
+func headerKey(header string) string {
+	replacer := strings.NewReplacer(
+		"İ", "i", "I", "i", "ı", "i",
+		"Ş", "s", "ş", "s",
+		"Ğ", "g", "ğ", "g",
+		"Ü", "u", "ü", "u",
+		"Ö", "o", "ö", "o",
+		"Ç", "c", "ç", "c",
+	)
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(replacer.Replace(header)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// samePath reports whether two paths point to the same file
+func samePath(a, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	if errA != nil || errB != nil {
+		return filepath.Clean(a) == filepath.Clean(b)
+	}
+	return absA == absB
+}
@@ -0,0 +1,119 @@
+package reshaper
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func namedTable(name string, headers []string, rows ...[]string) *models.DataTable {
+	dt := models.NewDataTable(headers)
+	dt.FileName = name
+	for _, row := range rows {
+		dt.AddRow(row)
+	}
+	return dt
+}
+
+func TestAppendAlignsColumns(t *testing.T) {
+	jan := namedTable("jan.csv", []string{"Müşteri Adı", "Tutar"}, []string{"Ali", "10"})
+	feb := namedTable("feb.csv", []string{"tutar", "musteri_adi", "Not"}, []string{"20", "Ayşe", "acil"})
+	mar := namedTable("mar.csv", []string{"Müşteri Adı"}, []string{"Can"})
+
+	result, err := Append([]*models.DataTable{jan, feb, mar}, AppendOptions{MatchNormalized: true, SourceColumn: "source"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantHeaders := []string{"Müşteri Adı", "Tutar", "Not", "source"}
+	if !reflect.DeepEqual(result.Table.Headers, wantHeaders) {
+		t.Errorf("Expected headers %v, got %v", wantHeaders, result.Table.Headers)
+	}
+	wantRows := [][]string{
+		{"Ali", "10", "", "jan.csv"},
+		{"Ayşe", "20", "acil", "feb.csv"},
+		{"Can", "", "", "mar.csv"},
+	}
+	if !reflect.DeepEqual(result.Table.Rows, wantRows) {
+		t.Errorf("Expected rows %v, got %v", wantRows, result.Table.Rows)
+	}
+
+	wantMismatches := []SchemaMismatch{
+		{File: "feb.csv", Extra: []string{"Not"}},
+		{File: "mar.csv", Missing: []string{"Tutar"}},
+	}
+	if !reflect.DeepEqual(result.Mismatches, wantMismatches) {
+		t.Errorf("Expected mismatches %v, got %v", wantMismatches, result.Mismatches)
+	}
+}
+
+func TestAppendExactHeaders(t *testing.T) {
+	a := namedTable("a.csv", []string{"Name"}, []string{"Ali"})
+	b := namedTable("b.csv", []string{"name"}, []string{"Can"})
+
+	result, err := Append([]*models.DataTable{a, b}, AppendOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := [][]string{{"Ali", ""}, {"", "Can"}}
+	if !reflect.DeepEqual(result.Table.Rows, want) {
+		t.Errorf("Expected exact header matching %v, got %v", want, result.Table.Rows)
+	}
+
+	if _, err := Append(nil, AppendOptions{}); err == nil {
+		t.Error("Expected error for no tables")
+	}
+}
+
+func TestAppendFiles(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"2024-01.csv": "id,amount\n1,10\n",
+		"2024-02.csv": "id,amount\n2,20\n",
+		"other.txt":   "ignored",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	result, err := AppendFiles(nil, []string{filepath.Join(dir, "*.csv")}, AppendOptions{SourceColumn: "file"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := [][]string{{"1", "10", "2024-01.csv"}, {"2", "20", "2024-02.csv"}}
+	if !reflect.DeepEqual(result.Table.Rows, want) {
+		t.Errorf("Expected %v, got %v", want, result.Table.Rows)
+	}
+
+	// The current table's own file is not appended again
+	current := namedTable("2024-01.csv", []string{"id", "amount"}, []string{"1", "10"})
+	current.FilePath = filepath.Join(dir, "2024-01.csv")
+	result, err = AppendFiles(current, []string{filepath.Join(dir, "*.csv")}, AppendOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Table.RowCount() != 2 {
+		t.Errorf("Expected 2 rows, got %v", result.Table.Rows)
+	}
+
+	// Literal paths load like patterns; files of tables already open are left out
+	open := namedTable("2024-02.csv", []string{"id", "amount"}, []string{"2", "20"})
+	open.FilePath = filepath.Join(dir, "2024-02.csv")
+	loaded, err := LoadAppendFiles(current, []string{filepath.Join(dir, "2024-02.csv")})
+	if err != nil || len(loaded) != 1 || loaded[0].RowCount() != 1 {
+		t.Errorf("Expected the literal path loaded, got %v (%v)", loaded, err)
+	}
+	loaded, err = LoadAppendFiles(current, []string{filepath.Join(dir, "*.csv")}, open)
+	if err != nil || len(loaded) != 0 {
+		t.Errorf("Expected open files left out, got %v (%v)", loaded, err)
+	}
+
+	if _, err := AppendFiles(nil, []string{filepath.Join(dir, "*.xlsx")}, AppendOptions{}); err == nil {
+		t.Error("Expected error when no files match")
+	}
+}
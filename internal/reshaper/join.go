@@ -0,0 +1,277 @@
+package reshaper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// JoinType defines which rows a join keeps
+type JoinType string
+
+const (
+	JoinInner JoinType = "inner" // Rows whose key exists in both tables
+	JoinLeft  JoinType = "left"  // Every left row, with right values where the key matches
+	JoinRight JoinType = "right" // Every right row, with left values where the key matches
+	JoinOuter JoinType = "outer" // Every row of both tables
+	JoinAnti  JoinType = "anti"  // Left rows whose key does not exist in the right table
+)
+
+// JoinTypes lists the available join types
+var JoinTypes = []JoinType{JoinInner, JoinLeft, JoinRight, JoinOuter, JoinAnti}
+
+// DefaultJoinSuffix is appended to right headers that clash with left headers
+const DefaultJoinSuffix = "_right"
+
+// JoinOptions configures a join of two tables
+type JoinOptions struct {
+	Type        JoinType `json:"type"`
+	LeftKeys    []string `json:"left_keys"`
+	RightKeys   []string `json:"right_keys,omitempty"`   // Key columns of the right table (defaults to LeftKeys)
+	RightSuffix string   `json:"right_suffix,omitempty"` // Suffix for clashing right headers (defaults to DefaultJoinSuffix)
+	IgnoreCase  bool     `json:"ignore_case,omitempty"`  // Match keys case-insensitively, ignoring surrounding spaces
+}
+
+// JoinResult holds the joined table and the keys that found no partner
+type JoinResult struct {
+	Table          *models.DataTable `json:"-"`
+	Matched        int               `json:"matched"`         // Left rows that matched at least one right row
+	UnmatchedLeft  []string          `json:"unmatched_left"`  // Distinct left keys missing from the right table
+	UnmatchedRight []string          `json:"unmatched_right"` // Distinct right keys missing from the left table
+}
+
+// Join combines the rows of two tables whose key columns are equal
+// Rows with a missing key value never match. A key found several times on
+// both sides produces every combination of the matching rows
+func Join(left, right *models.DataTable, opts JoinOptions) (JoinResult, error) {
+	if left == nil || right == nil {
+		return JoinResult{}, fmt.Errorf("two tables are needed for a join")
+	}
+
+	switch opts.Type {
+	case JoinInner, JoinLeft, JoinRight, JoinOuter, JoinAnti:
+	case "":
+		opts.Type = JoinInner
+	default:
+		return JoinResult{}, fmt.Errorf("unknown join type %q", opts.Type)
+	}
+
+	if len(opts.LeftKeys) == 0 {
+		return JoinResult{}, fmt.Errorf("no key columns given")
+	}
+	if len(opts.RightKeys) == 0 {
+		opts.RightKeys = opts.LeftKeys
+	}
+	if len(opts.LeftKeys) != len(opts.RightKeys) {
+		return JoinResult{}, fmt.Errorf("%d left keys but %d right keys", len(opts.LeftKeys), len(opts.RightKeys))
+	}
+	if opts.RightSuffix == "" {
+		opts.RightSuffix = DefaultJoinSuffix
+	}
+
+	leftCols, err := keyColumns(left, opts.LeftKeys, "left")
+	if err != nil {
+		return JoinResult{}, err
+	}
+	rightCols, err := keyColumns(right, opts.RightKeys, "right")
+	if err != nil {
+		return JoinResult{}, err
+	}
+
+	// Index the right rows by key
+	rightRows := make(map[string][]int)
+	for i, row := range right.Rows {
+		if key, ok := joinKey(right, row, rightCols, opts.IgnoreCase); ok {
+			rightRows[key] = append(rightRows[key], i)
+		}
+	}
+
+	// Right columns that are not keys are appended after the left columns
+	isRightKey := make(map[int]bool, len(rightCols))
+	for _, colIdx := range rightCols {
+		isRightKey[colIdx] = true
+	}
+	var extraCols []int
+	headers := append([]string(nil), left.Headers...)
+	if opts.Type != JoinAnti {
+		taken := make(map[string]bool, len(headers))
+		for _, h := range headers {
+			taken[h] = true
+		}
+		for colIdx, h := range right.Headers {
+			if isRightKey[colIdx] {
+				continue
+			}
+			extraCols = append(extraCols, colIdx)
+			headers = append(headers, uniqueHeader(h, opts.RightSuffix, taken))
+		}
+	}
+
+	result := JoinResult{Table: &models.DataTable{
+		Headers:  headers,
+		Rows:     make([][]string, 0, len(left.Rows)),
+		FilePath: left.FilePath,
+		FileName: left.FileName,
+		Nulls:    left.Nulls.Clone(),
+	}}
+
+	usedRight := make([]bool, len(right.Rows))
+	unmatchedLeft := make(map[string]bool)
+	for _, row := range left.Rows {
+		key, ok := joinKey(left, row, leftCols, opts.IgnoreCase)
+		matches := rightRows[key]
+		if !ok {
+			matches = nil
+		}
+
+		if len(matches) > 0 {
+			result.Matched++
+		} else if ok && !unmatchedLeft[key] {
+			unmatchedLeft[key] = true
+			result.UnmatchedLeft = append(result.UnmatchedLeft, displayKey(row, leftCols))
+		}
+
+		switch opts.Type {
+		case JoinAnti:
+			if len(matches) == 0 {
+				result.Table.Rows = append(result.Table.Rows, padRow(row, len(left.Headers)))
+			}
+			continue
+		case JoinInner, JoinRight:
+			if len(matches) == 0 {
+				continue
+			}
+		}
+
+		if len(matches) == 0 {
+			result.Table.Rows = append(result.Table.Rows, joinRow(row, len(left.Headers), nil, extraCols))
+			continue
+		}
+		for _, r := range matches {
+			usedRight[r] = true
+			result.Table.Rows = append(result.Table.Rows, joinRow(row, len(left.Headers), right.Rows[r], extraCols))
+		}
+	}
+
+	// Right rows without a partner; their key values go into the left key columns
+	unmatchedRight := make(map[string]bool)
+	for i, row := range right.Rows {
+		if usedRight[i] {
+			continue
+		}
+		if key, ok := joinKey(right, row, rightCols, opts.IgnoreCase); ok && !unmatchedRight[key] {
+			unmatchedRight[key] = true
+			result.UnmatchedRight = append(result.UnmatchedRight, displayKey(row, rightCols))
+		}
+
+		if opts.Type != JoinRight && opts.Type != JoinOuter {
+			continue
+		}
+		out := joinRow(nil, len(left.Headers), row, extraCols)
+		for k, colIdx := range rightCols {
+			out[leftCols[k]] = cell(row, colIdx)
+		}
+		result.Table.Rows = append(result.Table.Rows, out)
+	}
+
+	return result, nil
+}
+
+// Helper functions
+
+// keyColumns resolves key headers to column indexes
+func keyColumns(dt *models.DataTable, keys []string, side string) ([]int, error) {
+	cols := make([]int, len(keys))
+	for i, key := range keys {
+		cols[i] = dt.ColumnIndex(key)
+		if cols[i] == -1 {
+			return nil, fmt.Errorf("key column %q not found in %s table", key, side)
+		}
+	}
+	return cols, nil
+}
+
+// joinKey builds the lookup key of a row, false if any key cell is missing
+func joinKey(dt *models.DataTable, row []string, cols []int, ignoreCase bool) (string, bool) {
+	parts := make([]string, len(cols))
+	for i, colIdx := range cols {
+		value := cell(row, colIdx)
+		if dt.IsMissing(colIdx, value) {
+			return "", false
+		}
+		if ignoreCase {
+			value = strings.ToLower(strings.TrimSpace(value))
+		}
+		parts[i] = value
+	}
+	return strings.Join(parts, "\x00"), true
+}
+
+// displayKey formats the key cells of a row for reports
+func displayKey(row []string, cols []int) string {
+	parts := make([]string, len(cols))
+	for i, colIdx := range cols {
+		parts[i] = cell(row, colIdx)
+	}
+	return strings.Join(parts, " | ")
+}
+
+// joinRow concatenates a left row and the extra columns of a right row
+// A nil side produces empty cells
+func joinRow(left []string, width int, right []string, extraCols []int) []string {
+	out := make([]string, width, width+len(extraCols))
+	copy(out, left)
+	for _, colIdx := range extraCols {
+		out = append(out, cell(right, colIdx))
+	}
+	return out
+}
+
+// uniqueHeader appends suffix to a header until it no longer clashes
+func uniqueHeader(header, suffix string, taken map[string]bool) string {
+	for taken[header] {
+		header += suffix
+	}
+	taken[header] = true
+	return header
+}
+
+func padRow(row []string, width int) []string {
+	out := make([]string, width)
+	copy(out, row)
+	return out
+}
+
+func cell(row []string, colIdx int) string {
+	if colIdx < len(row) {
+		return row[colIdx]
+	}
+	return ""
+}
+
+// ParseJoinKeys parses a key list like "id" or "id=customer_id, year"
+// Returns the left and right key headers
+func ParseJoinKeys(spec string) ([]string, []string, error) {
+	var leftKeys, rightKeys []string
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		l, r, found := strings.Cut(part, "=")
+		l, r = strings.TrimSpace(l), strings.TrimSpace(r)
+		if !found {
+			r = l
+		}
+		if l == "" || r == "" {
+			return nil, nil, fmt.Errorf("invalid key %q (use column or left=right)", part)
+		}
+		leftKeys = append(leftKeys, l)
+		rightKeys = append(rightKeys, r)
+	}
+	if len(leftKeys) == 0 {
+		return nil, nil, fmt.Errorf("no key columns given")
+	}
+	return leftKeys, rightKeys, nil
+}
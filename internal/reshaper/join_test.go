@@ -0,0 +1,159 @@
+package reshaper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func joinTables() (*models.DataTable, *models.DataTable) {
+	left := models.NewDataTable([]string{"id", "name"})
+	left.AddRow([]string{"1", "Ali"})
+	left.AddRow([]string{"2", "Ayşe"})
+	left.AddRow([]string{"3", "Can"})
+	left.AddRow([]string{"", "Deniz"})
+
+	right := models.NewDataTable([]string{"customer_id", "name", "city"})
+	right.AddRow([]string{"1", "ALI", "Ankara"})
+	right.AddRow([]string{"2", "AYSE", "İzmir"})
+	right.AddRow([]string{"2", "AYSE", "Bursa"})
+	right.AddRow([]string{"4", "EMRE", "Konya"})
+	return left, right
+}
+
+func TestJoinTypes(t *testing.T) {
+	tests := []struct {
+		joinType JoinType
+		want     [][]string
+	}{
+		{JoinInner, [][]string{
+			{"1", "Ali", "ALI", "Ankara"},
+			{"2", "Ayşe", "AYSE", "İzmir"},
+			{"2", "Ayşe", "AYSE", "Bursa"},
+		}},
+		{JoinLeft, [][]string{
+			{"1", "Ali", "ALI", "Ankara"},
+			{"2", "Ayşe", "AYSE", "İzmir"},
+			{"2", "Ayşe", "AYSE", "Bursa"},
+			{"3", "Can", "", ""},
+			{"", "Deniz", "", ""},
+		}},
+		{JoinRight, [][]string{
+			{"1", "Ali", "ALI", "Ankara"},
+			{"2", "Ayşe", "AYSE", "İzmir"},
+			{"2", "Ayşe", "AYSE", "Bursa"},
+			{"4", "", "EMRE", "Konya"},
+		}},
+		{JoinOuter, [][]string{
+			{"1", "Ali", "ALI", "Ankara"},
+			{"2", "Ayşe", "AYSE", "İzmir"},
+			{"2", "Ayşe", "AYSE", "Bursa"},
+			{"3", "Can", "", ""},
+			{"", "Deniz", "", ""},
+			{"4", "", "EMRE", "Konya"},
+		}},
+		{JoinAnti, [][]string{
+			{"3", "Can"},
+			{"", "Deniz"},
+		}},
+	}
+
+	for _, tt := range tests {
+		left, right := joinTables()
+		result, err := Join(left, right, JoinOptions{
+			Type:      tt.joinType,
+			LeftKeys:  []string{"id"},
+			RightKeys: []string{"customer_id"},
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.joinType, err)
+		}
+		if !reflect.DeepEqual(result.Table.Rows, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.joinType, tt.want, result.Table.Rows)
+		}
+	}
+}
+
+func TestJoinHeadersAndReport(t *testing.T) {
+	left, right := joinTables()
+	result, err := Join(left, right, JoinOptions{
+		Type:      JoinLeft,
+		LeftKeys:  []string{"id"},
+		RightKeys: []string{"customer_id"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []string{"id", "name", "name_right", "city"}
+	if !reflect.DeepEqual(result.Table.Headers, want) {
+		t.Errorf("Expected headers %v, got %v", want, result.Table.Headers)
+	}
+	if result.Matched != 2 {
+		t.Errorf("Expected 2 matched rows, got %d", result.Matched)
+	}
+	if !reflect.DeepEqual(result.UnmatchedLeft, []string{"3"}) || !reflect.DeepEqual(result.UnmatchedRight, []string{"4"}) {
+		t.Errorf("Unexpected unmatched keys: %v / %v", result.UnmatchedLeft, result.UnmatchedRight)
+	}
+}
+
+func TestJoinCompositeKeys(t *testing.T) {
+	left := models.NewDataTable([]string{"year", "region", "sales"})
+	left.AddRow([]string{"2024", "North", "10"})
+	left.AddRow([]string{"2024", "South", "20"})
+
+	right := models.NewDataTable([]string{"year", "region", "target"})
+	right.AddRow([]string{"2024", " north ", "15"})
+	right.AddRow([]string{"2025", "South", "25"})
+
+	result, err := Join(left, right, JoinOptions{
+		Type:       JoinInner,
+		LeftKeys:   []string{"year", "region"},
+		IgnoreCase: true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := [][]string{{"2024", "North", "10", "15"}}
+	if !reflect.DeepEqual(result.Table.Rows, want) {
+		t.Errorf("Expected %v, got %v", want, result.Table.Rows)
+	}
+	if !reflect.DeepEqual(result.UnmatchedLeft, []string{"2024 | South"}) {
+		t.Errorf("Unexpected unmatched left keys: %v", result.UnmatchedLeft)
+	}
+}
+
+func TestJoinErrors(t *testing.T) {
+	left, right := joinTables()
+
+	if _, err := Join(left, right, JoinOptions{LeftKeys: []string{"id"}}); err == nil {
+		t.Error("Expected error for key missing from the right table")
+	}
+	if _, err := Join(left, right, JoinOptions{}); err == nil {
+		t.Error("Expected error for no keys")
+	}
+	if _, err := Join(left, right, JoinOptions{Type: "cross", LeftKeys: []string{"id"}, RightKeys: []string{"customer_id"}}); err == nil {
+		t.Error("Expected error for unknown join type")
+	}
+	if _, err := Join(left, right, JoinOptions{LeftKeys: []string{"id", "name"}, RightKeys: []string{"customer_id"}}); err == nil {
+		t.Error("Expected error for mismatched key counts")
+	}
+}
+
+func TestParseJoinKeys(t *testing.T) {
+	left, right, err := ParseJoinKeys("id = customer_id, year")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(left, []string{"id", "year"}) || !reflect.DeepEqual(right, []string{"customer_id", "year"}) {
+		t.Errorf("Unexpected keys: %v / %v", left, right)
+	}
+
+	for _, spec := range []string{"", " , ", "id="} {
+		if _, _, err := ParseJoinKeys(spec); err == nil {
+			t.Errorf("Expected error for %q", spec)
+		}
+	}
+}
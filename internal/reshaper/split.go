@@ -0,0 +1,220 @@
+package reshaper
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/veliulugut/snapclean/internal/file"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// PartitionOptions configures how a table is split
+// Either Columns or ChunkSize must be set
+type PartitionOptions struct {
+	Columns   []string `json:"columns,omitempty"`    // One partition per distinct combination of these columns
+	ChunkSize int      `json:"chunk_size,omitempty"` // Partitions of at most this many rows
+}
+
+// Partition is one part of a split table
+type Partition struct {
+	Name  string            `json:"name"` // Column values joined with "_" (made unique), or part_001 for chunks
+	Table *models.DataTable `json:"-"`
+}
+
+// maxNameLength limits file names built from cell values
+const maxNameLength = 100
+
+// maxSheetNameLength is the longest sheet name Excel accepts
+const maxSheetNameLength = 31
+
+// PartitionTable splits a table by the distinct values of columns or into chunks of rows
+// Partitions keep the order in which their first row appears
+func PartitionTable(dt *models.DataTable, opts PartitionOptions) ([]Partition, error) {
+	if dt == nil {
+		return nil, fmt.Errorf("no data to split")
+	}
+
+	switch {
+	case len(opts.Columns) > 0 && opts.ChunkSize > 0:
+		return nil, fmt.Errorf("split by columns or by row count, not both")
+
+	case opts.ChunkSize > 0:
+		var parts []Partition
+		for start := 0; start < len(dt.Rows); start += opts.ChunkSize {
+			end := min(start+opts.ChunkSize, len(dt.Rows))
+			parts = append(parts, Partition{
+				Name:  fmt.Sprintf("part_%03d", len(parts)+1),
+				Table: subTable(dt, dt.Rows[start:end]),
+			})
+		}
+		return parts, nil
+
+	case len(opts.Columns) > 0:
+		cols := make([]int, len(opts.Columns))
+		for i, column := range opts.Columns {
+			if cols[i] = dt.ColumnIndex(column); cols[i] == -1 {
+				return nil, fmt.Errorf("column %q not found", column)
+			}
+		}
+
+		// Rows are grouped by their values, which may contain "_"; names are only for display
+		var parts []Partition
+		index := make(map[string]int)
+		taken := make(map[string]bool)
+		for _, row := range dt.Rows {
+			keys := make([]string, len(cols))
+			values := make([]string, len(cols))
+			for i, colIdx := range cols {
+				values[i] = strings.TrimSpace(cell(row, colIdx))
+				keys[i] = "v" + values[i]
+				if dt.IsMissing(colIdx, values[i]) {
+					keys[i] = "m"
+					values[i] = "empty"
+				}
+			}
+			key := strings.Join(keys, "\x00")
+
+			i, ok := index[key]
+			if !ok {
+				i = len(parts)
+				index[key] = i
+				name := uniqueName(strings.Join(values, "_"), maxNameLength, taken)
+				parts = append(parts, Partition{Name: name, Table: subTable(dt, nil)})
+			}
+			parts[i].Table.Rows = append(parts[i].Table.Rows, append([]string(nil), row...))
+		}
+		return parts, nil
+
+	default:
+		return nil, fmt.Errorf("no split columns or row count given")
+	}
+}
+
+// SavePartitions writes each partition to its own file in dir
+// Files are named prefix_name.ext; returns the paths written
+func SavePartitions(parts []Partition, dir, prefix, ext string) ([]string, error) {
+	ext = "." + strings.TrimPrefix(strings.ToLower(ext), ".")
+	if ext != ".csv" && ext != ".xlsx" {
+		return nil, fmt.Errorf("unsupported file format: %s (supported: .csv, .xlsx)", ext)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	taken := make(map[string]bool, len(parts))
+	paths := make([]string, len(parts))
+	for i, part := range parts {
+		name := part.Name
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+		name = uniqueName(SafeFileName(name), maxNameLength, taken)
+
+		paths[i] = filepath.Join(dir, name+ext)
+		if err := file.SaveFile(part.Table, paths[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return paths, nil
+}
+
+// SavePartitionsWorkbook writes each partition to its own sheet of one Excel file
+func SavePartitionsWorkbook(parts []Partition, path string) error {
+	taken := make(map[string]bool, len(parts))
+	sheets := make([]file.Sheet, len(parts))
+	for i, part := range parts {
+		sheets[i] = file.Sheet{
+			Name:  uniqueName(safeSheetName(part.Name), maxSheetNameLength, taken),
+			Table: part.Table,
+		}
+	}
+	return file.SaveWorkbook(path, sheets)
+}
+
+// SafeFileName turns a value into a name that is valid on every platform
+// Separators and reserved characters become "_", and reserved device names are prefixed
+func SafeFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`<>:"/\|?*`, r) || unicode.IsControl(r) {
+			return '_'
+		}
+		if unicode.IsSpace(r) {
+			return ' '
+		}
+		return r
+	}, name)
+	name = strings.Trim(name, " .")
+	name = truncateRunes(name, maxNameLength)
+
+	if name == "" {
+		return "empty"
+	}
+
+	base := strings.ToUpper(name)
+	if i := strings.IndexByte(base, '.'); i != -1 {
+		base = base[:i]
+	}
+	switch base {
+	case "CON", "PRN", "AUX", "NUL",
+		"COM1", "COM2", "COM3", "COM4", "COM5", "COM6", "COM7", "COM8", "COM9",
+		"LPT1", "LPT2", "LPT3", "LPT4", "LPT5", "LPT6", "LPT7", "LPT8", "LPT9":
+		return "_" + name
+	}
+
+	return name
+}
+
+// Helper functions
+
+// subTable creates a table with the columns of dt and the given rows
+func subTable(dt *models.DataTable, rows [][]string) *models.DataTable {
+	part := &models.DataTable{
+		Headers:  append([]string(nil), dt.Headers...),
+		Rows:     make([][]string, len(rows)),
+		FilePath: dt.FilePath,
+		FileName: dt.FileName,
+		Nulls:    dt.Nulls.Clone(),
+	}
+	for i, row := range rows {
+		part.Rows[i] = append([]string(nil), row...)
+	}
+	return part
+}
+
+// safeSheetName removes the characters Excel does not allow in sheet names
+func safeSheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) || unicode.IsControl(r) {
+			return '_'
+		}
+		return r
+	}, name)
+	name = strings.Trim(name, " '")
+	if name == "" {
+		return "empty"
+	}
+	return truncateRunes(name, maxSheetNameLength)
+}
+
+// uniqueName adds a numeric suffix to names already taken, ignoring case
+func uniqueName(name string, maxLength int, taken map[string]bool) string {
+	candidate := name
+	for n := 2; taken[strings.ToLower(candidate)]; n++ {
+		suffix := fmt.Sprintf("_%d", n)
+		candidate = truncateRunes(name, maxLength-len(suffix)) + suffix
+	}
+	taken[strings.ToLower(candidate)] = true
+	return candidate
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) > n {
+		return string(runes[:n])
+	}
+	return s
+}
@@ -0,0 +1,132 @@
+package reshaper
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/veliulugut/snapclean/internal/file"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+func regionTable() *models.DataTable {
+	dt := models.NewDataTable([]string{"region", "city", "sales"})
+	dt.AddRow([]string{"Marmara", "İstanbul", "10"})
+	dt.AddRow([]string{"Ege", "İzmir", "20"})
+	dt.AddRow([]string{"Marmara", "Bursa", "30"})
+	dt.AddRow([]string{"", "Unknown", "40"})
+	return dt
+}
+
+func TestPartitionByColumns(t *testing.T) {
+	parts, err := PartitionTable(regionTable(), PartitionOptions{Columns: []string{"region"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var names []string
+	for _, p := range parts {
+		names = append(names, p.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"Marmara", "Ege", "empty"}) {
+		t.Errorf("Unexpected partitions: %v", names)
+	}
+	if parts[0].Table.RowCount() != 2 || parts[0].Table.Rows[1][1] != "Bursa" {
+		t.Errorf("Unexpected Marmara rows: %v", parts[0].Table.Rows)
+	}
+}
+
+func TestPartitionCollidingNames(t *testing.T) {
+	dt := models.NewDataTable([]string{"a", "b"})
+	dt.AddRow([]string{"x_y", "z"})
+	dt.AddRow([]string{"x", "y_z"})
+	dt.AddRow([]string{"empty", "q"})
+	dt.AddRow([]string{"", "q"})
+	dt.AddRow([]string{"x", "y_z"})
+
+	parts, err := PartitionTable(dt, PartitionOptions{Columns: []string{"a", "b"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var names []string
+	for _, p := range parts {
+		names = append(names, p.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"x_y_z", "x_y_z_2", "empty_q", "empty_q_2"}) {
+		t.Errorf("Expected colliding keys kept apart, got %v", names)
+	}
+	if parts[1].Table.RowCount() != 2 || parts[3].Table.Rows[0][0] != "" {
+		t.Errorf("Unexpected partition rows: %v, %v", parts[1].Table.Rows, parts[3].Table.Rows)
+	}
+}
+
+func TestPartitionByChunks(t *testing.T) {
+	parts, err := PartitionTable(regionTable(), PartitionOptions{ChunkSize: 3})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(parts) != 2 || parts[0].Name != "part_001" || parts[1].Table.RowCount() != 1 {
+		t.Errorf("Unexpected chunks: %+v", parts)
+	}
+
+	if _, err := PartitionTable(regionTable(), PartitionOptions{}); err == nil {
+		t.Error("Expected error without columns or chunk size")
+	}
+	if _, err := PartitionTable(regionTable(), PartitionOptions{Columns: []string{"country"}}); err == nil {
+		t.Error("Expected error for unknown column")
+	}
+}
+
+func TestSafeFileName(t *testing.T) {
+	tests := map[string]string{
+		"Marmara":        "Marmara",
+		"A/B: test?":     "A_B_ test_",
+		"  ..hidden.  ":  "hidden",
+		"con":            "_con",
+		"nul.txt":        "_nul.txt",
+		"":               "empty",
+		"Güneydoğu Ana.": "Güneydoğu Ana",
+	}
+	for input, want := range tests {
+		if got := SafeFileName(input); got != want {
+			t.Errorf("SafeFileName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestSavePartitions(t *testing.T) {
+	dt := models.NewDataTable([]string{"region"})
+	dt.AddRow([]string{"North"})
+	dt.AddRow([]string{"north"})
+	dt.AddRow([]string{"A/B"})
+
+	parts, err := PartitionTable(dt, PartitionOptions{Columns: []string{"region"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	dir := t.TempDir()
+	paths, err := SavePartitions(parts, dir, "sales", "csv")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []string{
+		filepath.Join(dir, "sales_North.csv"),
+		filepath.Join(dir, "sales_north_2.csv"),
+		filepath.Join(dir, "sales_A_B.csv"),
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Expected %v, got %v", want, paths)
+	}
+	if loaded, err := file.LoadFile(paths[1]); err != nil || loaded.Rows[0][0] != "north" {
+		t.Errorf("Unexpected saved partition: %v", err)
+	}
+
+	workbook := filepath.Join(dir, "regions.xlsx")
+	if err := SavePartitionsWorkbook(parts, workbook); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
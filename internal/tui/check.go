@@ -0,0 +1,289 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/report"
+	"github.com/veliulugut/snapclean/internal/tui/components"
+)
+
+// runChecks validates the table and highlights the offending cells
+func (m *AppModel) runChecks() {
+	m.clearCellMarks()
+	m.checkResult = cleaner.ValidateData(m.dataTable)
+	m.checkViolations = nil
+	m.checkMessage = ""
+	m.issuePage = 0
+
+	issues := append([]cleaner.Issue(nil), m.checkResult.Issues...)
+	if len(m.rules.Rules) > 0 {
+		violations, err := cleaner.ValidateRules(m.dataTable, m.rules.Rules)
+		if err != nil {
+			m.checkMessage = fmt.Sprintf("✗ %v", err)
+		} else {
+			m.checkViolations = violations
+			issues = append(issues, cleaner.RuleIssues(violations)...)
+			cleaner.SortIssues(m.dataTable, issues)
+		}
+	}
+	m.issues = issues
+
+	for _, issue := range issues {
+		if cell, ok := issue.Cell(m.dataTable); ok {
+			m.flagged = append(m.flagged, cell)
+		}
+	}
+
+	if m.checkMessage == "" && len(m.flagged) > 0 {
+		m.checkMessage = fmt.Sprintf("⚠ %d cells highlighted in the table view (n/N to jump between issues)", len(m.flagged))
+	}
+}
+
+// jumpToIssue selects the next (step 1) or previous (step -1) issue and scrolls to it
+func (m *AppModel) jumpToIssue(step int) {
+	if len(m.issues) == 0 {
+		m.tableMessage = "⚠ No issues to show. Run CHECK from the menu first."
+		return
+	}
+
+	switch {
+	case m.issueIndex < 0 && step < 0:
+		m.issueIndex = len(m.issues) - 1
+	default:
+		m.issueIndex = (m.issueIndex + step + len(m.issues)) % len(m.issues)
+	}
+	issue := m.issues[m.issueIndex]
+
+	location := "column " + issue.Column
+	if issue.Row >= 0 {
+		location = fmt.Sprintf("row %d", issue.Row+1)
+		if issue.Column != "" {
+			location += ", " + issue.Column
+		}
+
+		pos := m.rowPosition(issue.Row)
+		if pos < 0 {
+			location += " (hidden by filter)"
+		} else {
+			m.moveCursor(pos-m.cursorRow, 0)
+		}
+	}
+
+	if colPos := m.columnPosition(m.dataTable.ColumnIndex(issue.Column)); colPos >= 0 {
+		m.moveCursor(0, colPos-m.cursorCol)
+	}
+
+	m.tableMessage = fmt.Sprintf("Issue %d/%d  %s  [%s %s] %s",
+		m.issueIndex+1, len(m.issues), location, issue.Severity, issue.Kind, issue.Message)
+}
+
+// issueFocus returns the cell of the selected issue, if it has one
+func (m AppModel) issueFocus() *models.CellRef {
+	if m.issueIndex < 0 || m.issueIndex >= len(m.issues) {
+		return nil
+	}
+	if cell, ok := m.issues[m.issueIndex].Cell(m.dataTable); ok {
+		return &cell
+	}
+	return nil
+}
+
+// handleCheckNavigation handles key presses in the QA check view
+func (m AppModel) handleCheckNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "b", "esc":
+		m.currentView = menuView
+		return m, nil
+
+	case "q", "ctrl+c":
+		return m, tea.Quit
+
+	case "enter", "v":
+		m.currentView = tableView
+		m.columnMenuMode = false
+
+	case "left", "pgup":
+		if m.issuePage > 0 {
+			m.issuePage--
+		}
+
+	case "right", "pgdown":
+		if _, pages := cleaner.PageIssues(m.issues, 0, components.CheckPageSize); m.issuePage < pages-1 {
+			m.issuePage++
+		}
+
+	case "l":
+		m.openPrompt("LOAD RULES", "Rules file path:", "rules.json", func(m AppModel, path string) AppModel {
+			rs, err := cleaner.LoadRules(path)
+			if err != nil {
+				m.checkMessage = fmt.Sprintf("✗ %v", err)
+				return m
+			}
+			m.rules = rs
+			m.runChecks()
+			return m
+		})
+
+	case "w":
+		if len(m.rules.Rules) == 0 {
+			m.checkMessage = "⚠ No rules to save. Add rules from the column menu (a)."
+			return m, nil
+		}
+		m.openPrompt("SAVE RULES", "File path:", "rules.json", func(m AppModel, path string) AppModel {
+			if err := m.rules.Save(path); err != nil {
+				m.checkMessage = fmt.Sprintf("✗ %v", err)
+				return m
+			}
+			m.checkMessage = fmt.Sprintf("✓ Saved %d rules to %s", len(m.rules.Rules), path)
+			return m
+		})
+
+	case "x":
+		m.rules = cleaner.RuleSet{}
+		m.runChecks()
+
+	case "e":
+		m.openPrompt("EXPORT REPORT", "File path (.html, .md or .json):", "report.html", func(m AppModel, path string) AppModel {
+			r, err := report.New(m.dataTable, m.rules.Rules)
+			if err == nil {
+				err = r.Save(path)
+			}
+			if err != nil {
+				m.checkMessage = fmt.Sprintf("✗ %v", err)
+				return m
+			}
+			m.checkMessage = fmt.Sprintf("✓ Report saved to %s", path)
+			return m
+		})
+	}
+
+	return m, nil
+}
+
+// parseOutlierSpec turns the outlier prompt input into detection options:
+// "iqr 1.5", "zscore 3", "modified_zscore 3.5" or "bounds 0 100" (* leaves a side open)
+func parseOutlierSpec(column, spec string) (cleaner.OutlierOptions, error) {
+	opts := cleaner.OutlierOptions{Column: column}
+
+	fields := strings.Fields(strings.ToLower(spec))
+	if len(fields) == 0 {
+		return opts, fmt.Errorf("no method given")
+	}
+	opts.Method = cleaner.OutlierMethod(fields[0])
+
+	if opts.Method == cleaner.OutlierBounds {
+		if len(fields) != 3 {
+			return opts, fmt.Errorf("bounds needs a lower and an upper bound, e.g. bounds 0 100")
+		}
+		var err error
+		if opts.Lower, err = parseBound(fields[1]); err != nil {
+			return opts, err
+		}
+		if opts.Upper, err = parseBound(fields[2]); err != nil {
+			return opts, err
+		}
+		return opts, nil
+	}
+
+	if len(fields) > 1 {
+		k, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return opts, fmt.Errorf("invalid threshold %q", fields[1])
+		}
+		opts.Threshold = k
+	}
+	return opts, nil
+}
+
+// parseBound parses one side of a bounds spec, "*" meaning unbounded
+func parseBound(field string) (*float64, error) {
+	if field == "*" {
+		return nil, nil
+	}
+	n, err := strconv.ParseFloat(field, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bound %q", field)
+	}
+	return &n, nil
+}
+
+// parseRuleSpec turns the rule prompt input into a rule for a column, e.g.
+// "required", "unique", "type integer", "min 0", "max 100", "length 2 50",
+// "pattern ^[A-Z]{3}$", "allowed a,b,c", "date_range 2020-01-01 *",
+// "compare >= start_date" or "expression price > 0" (* leaves a side open)
+func parseRuleSpec(column, spec string) (cleaner.Rule, error) {
+	rule := cleaner.Rule{Column: column}
+
+	name, rest, _ := strings.Cut(strings.TrimSpace(spec), " ")
+	rule.Type = cleaner.RuleType(strings.ToLower(name))
+	rest = strings.TrimSpace(rest)
+	args := strings.Fields(rest)
+
+	var err error
+	switch rule.Type {
+	case cleaner.RuleRequired, cleaner.RuleUnique:
+
+	case cleaner.RuleDataType:
+		rule.DataType = strings.ToLower(rest)
+
+	case cleaner.RuleMin:
+		rule.Min, err = parseBound(rest)
+
+	case cleaner.RuleMax:
+		rule.Max, err = parseBound(rest)
+
+	case cleaner.RuleLength:
+		if len(args) != 2 {
+			return rule, fmt.Errorf("length needs a minimum and a maximum, e.g. length 2 50")
+		}
+		if rule.MinLength, err = parseLength(args[0]); err == nil {
+			rule.MaxLength, err = parseLength(args[1])
+		}
+
+	case cleaner.RulePattern:
+		rule.Pattern = rest
+
+	case cleaner.RuleAllowed:
+		rule.Values = splitHeaders(rest)
+
+	case cleaner.RuleDateRange:
+		if len(args) != 2 {
+			return rule, fmt.Errorf("date_range needs two dates, e.g. date_range 2020-01-01 *")
+		}
+		if args[0] != "*" {
+			rule.After = args[0]
+		}
+		if args[1] != "*" {
+			rule.Before = args[1]
+		}
+
+	case cleaner.RuleCompare:
+		op, other, _ := strings.Cut(rest, " ")
+		rule.Operator, rule.Other = op, strings.TrimSpace(other)
+
+	case cleaner.RuleExpression:
+		rule.Expression = rest
+
+	default:
+		return rule, fmt.Errorf("unknown rule %q", name)
+	}
+
+	return rule, err
+}
+
+// parseLength parses a length bound, "*" meaning unbounded
+func parseLength(field string) (*int, error) {
+	if field == "*" {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(field)
+	if err != nil {
+		return nil, fmt.Errorf("invalid length %q", field)
+	}
+	return &n, nil
+}
@@ -0,0 +1,202 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/recipe"
+)
+
+// markedColumns returns the headers of the marked columns, or the selected one when none are marked
+func (m AppModel) markedColumns() []string {
+	if len(m.columnMarks) == 0 {
+		return []string{m.dataTable.Headers[m.selectedColumn]}
+	}
+
+	indices := make([]int, 0, len(m.columnMarks))
+	for colIdx := range m.columnMarks {
+		if colIdx < m.dataTable.ColumnCount() {
+			indices = append(indices, colIdx)
+		}
+	}
+	sort.Ints(indices)
+
+	headers := make([]string, len(indices))
+	for i, colIdx := range indices {
+		headers[i] = m.dataTable.Headers[colIdx]
+	}
+	return headers
+}
+
+// applyColumnOp replaces the table with the result of a column operation and records it
+func (m *AppModel) applyColumnOp(result *models.DataTable, err error, op string, params any, message string) {
+	if err != nil {
+		m.columnMessage = fmt.Sprintf("✗ %v", err)
+		return
+	}
+
+	m.dataTable = result
+	m.clearCellMarks()
+	m.columnMarks = nil
+	m.viewFilterTable = nil
+	m.recordStep(op, params)
+	m.selectedColumn = min(m.selectedColumn, max(0, result.ColumnCount()-1))
+	m.columnMessage = message
+}
+
+// deleteColumns removes the marked or selected columns
+func (m *AppModel) deleteColumns() {
+	columns := m.markedColumns()
+	if len(columns) == m.dataTable.ColumnCount() {
+		m.columnMessage = "⚠ Cannot delete every column."
+		return
+	}
+	result, err := cleaner.DeleteColumns(m.dataTable, columns)
+	m.applyColumnOp(result, err, recipe.OpDeleteColumns, recipe.ColumnsParams{Columns: columns},
+		fmt.Sprintf("✓ Deleted %s (U to undo)", strings.Join(columns, ", ")))
+}
+
+// keepColumns removes every column that is not marked
+func (m *AppModel) keepColumns() {
+	if len(m.columnMarks) == 0 {
+		m.columnMessage = "⚠ Mark the columns to keep with Space first."
+		return
+	}
+	columns := m.markedColumns()
+	result, err := cleaner.KeepColumns(m.dataTable, columns)
+	m.applyColumnOp(result, err, recipe.OpKeepColumns, recipe.ColumnsParams{Columns: columns},
+		fmt.Sprintf("✓ Kept %d columns (U to undo)", len(columns)))
+}
+
+// openRenameColumn edits the header of the selected column
+func (m *AppModel) openRenameColumn() {
+	header := m.dataTable.Headers[m.selectedColumn]
+	m.openPrompt("RENAME COLUMN", fmt.Sprintf("New name for %q:", header), header, func(m AppModel, name string) AppModel {
+		name = strings.TrimSpace(name)
+		if name == header {
+			return m
+		}
+		result, err := cleaner.RenameColumn(m.dataTable, header, name)
+		m.applyColumnOp(result, err, recipe.OpRenameColumn, recipe.RenameParams{Column: header, Name: name},
+			fmt.Sprintf("✓ Renamed %s → %s", header, name))
+		if err == nil && m.hiddenColumns[header] {
+			delete(m.hiddenColumns, header)
+			m.hiddenColumns[name] = true
+		}
+		if width, ok := m.columnWidths[header]; ok && err == nil {
+			delete(m.columnWidths, header)
+			m.columnWidths[name] = width
+		}
+		return m
+	})
+}
+
+// openDuplicateColumn copies the selected column next to itself
+func (m *AppModel) openDuplicateColumn() {
+	header := m.dataTable.Headers[m.selectedColumn]
+	m.openPrompt("DUPLICATE COLUMN", fmt.Sprintf("Name of the copy of %q:", header), header+"_copy", func(m AppModel, name string) AppModel {
+		name = strings.TrimSpace(name)
+		result, err := cleaner.DuplicateColumn(m.dataTable, header, name)
+		m.applyColumnOp(result, err, recipe.OpDuplicateColumn, recipe.RenameParams{Column: header, Name: name},
+			fmt.Sprintf("✓ Duplicated %s as %s", header, name))
+		return m
+	})
+}
+
+// moveColumn moves the selected column to a 0-based position and keeps it selected
+func (m *AppModel) moveColumn(position int) {
+	if position < 0 || position >= m.dataTable.ColumnCount() || position == m.selectedColumn {
+		return
+	}
+	header := m.dataTable.Headers[m.selectedColumn]
+	result, err := cleaner.MoveColumn(m.dataTable, header, position)
+	m.applyColumnOp(result, err, recipe.OpMoveColumn, recipe.MoveParams{Column: header, Position: position},
+		fmt.Sprintf("✓ Moved %s to position %d", header, position+1))
+	if err == nil {
+		m.selectedColumn = position
+	}
+}
+
+// openMoveColumn asks for the position to move the selected column to
+func (m *AppModel) openMoveColumn() {
+	header := m.dataTable.Headers[m.selectedColumn]
+	label := fmt.Sprintf("Move %q to position (1-%d):", header, m.dataTable.ColumnCount())
+	m.openPrompt("MOVE COLUMN", label, strconv.Itoa(m.selectedColumn+1), func(m AppModel, value string) AppModel {
+		position, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || position < 1 || position > m.dataTable.ColumnCount() {
+			m.columnMessage = fmt.Sprintf("✗ Invalid position %q", value)
+			return m
+		}
+		m.moveColumn(position - 1)
+		return m
+	})
+}
+
+// swapColumns exchanges two columns
+func (m *AppModel) swapColumns(col1, col2 int) {
+	a, b := m.dataTable.Headers[col1], m.dataTable.Headers[col2]
+	result, err := cleaner.SwapColumns(m.dataTable, a, b)
+	m.applyColumnOp(result, err, recipe.OpSwapColumns, recipe.SwapParams{Column: a, Other: b},
+		fmt.Sprintf("✓ Swapped columns %d ↔ %d", col1+1, col2+1))
+}
+
+// toggleHiddenColumns hides the marked or selected columns from the table view, or shows them again
+func (m *AppModel) toggleHiddenColumns() {
+	columns := m.markedColumns()
+	hide := !m.hiddenColumns[columns[0]]
+
+	if m.hiddenColumns == nil {
+		m.hiddenColumns = make(map[string]bool)
+	}
+	for _, header := range columns {
+		if hide {
+			m.hiddenColumns[header] = true
+		} else {
+			delete(m.hiddenColumns, header)
+		}
+	}
+	if len(m.visibleColumns()) == 0 {
+		for _, header := range columns {
+			delete(m.hiddenColumns, header)
+		}
+		m.columnMessage = "⚠ At least one column must stay visible."
+		return
+	}
+
+	m.columnMarks = nil
+	m.columnOffset = 0
+	if hide {
+		m.columnMessage = fmt.Sprintf("✓ Hidden from view: %s (data is kept)", strings.Join(columns, ", "))
+	} else {
+		m.columnMessage = fmt.Sprintf("✓ Shown again: %s", strings.Join(columns, ", "))
+	}
+}
+
+// visibleColumns returns the indices of the columns shown in the table view
+func (m AppModel) visibleColumns() []int {
+	if m.dataTable == nil {
+		return nil
+	}
+
+	cols := make([]int, 0, m.dataTable.ColumnCount())
+	for colIdx, header := range m.dataTable.Headers {
+		if !m.hiddenColumns[header] {
+			cols = append(cols, colIdx)
+		}
+	}
+	return cols
+}
+
+// columnPosition returns the position of a column among the visible ones, -1 if hidden
+func (m AppModel) columnPosition(colIdx int) int {
+	for pos, c := range m.visibleColumns() {
+		if c == colIdx {
+			return pos
+		}
+	}
+	return -1
+}
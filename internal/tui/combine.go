@@ -0,0 +1,293 @@
+package tui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/veliulugut/snapclean/internal/file"
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/recipe"
+	"github.com/veliulugut/snapclean/internal/reshaper"
+	"github.com/veliulugut/snapclean/internal/utils"
+)
+
+type joinFileSelectedMsg struct {
+	path string
+}
+
+type joinFileLoadedMsg struct {
+	path      string
+	err       error
+	dataTable *models.DataTable
+}
+
+// openJoin picks the second table of a join: an open dataset or a file
+func (m AppModel) openJoin() (tea.Model, tea.Cmd) {
+	if m.dataTable == nil {
+		m.statusText = "⚠ No data loaded. Please load a file first."
+		return m, nil
+	}
+	if len(m.datasets) < 2 {
+		m.statusText = "⏳ Opening file picker for the file to join..."
+		return m, pickJoinFile
+	}
+
+	tabs, first := m.otherTabs()
+	m.openPrompt("JOIN", "Open dataset to join ("+tabs+"), empty to pick a file:", first, func(m AppModel, value string) AppModel {
+		if strings.TrimSpace(value) == "" {
+			m.statusText = "⏳ Opening file picker for the file to join..."
+			m.promptCmd = pickJoinFile
+			return m
+		}
+		right, ok, err := m.tabTable(value)
+		if !ok {
+			m.statusText = fmt.Sprintf("✗ %q is not another open dataset", strings.TrimSpace(value))
+			return m
+		}
+		if err != nil {
+			m.statusText = fmt.Sprintf("✗ %v", err)
+			return m
+		}
+		return m.promptJoin(joinFileLoadedMsg{path: right.FilePath, dataTable: right})
+	})
+	return m, nil
+}
+
+// pickJoinFile opens the file picker for the second file of a join
+func pickJoinFile() tea.Msg {
+	path, err := utils.OpenFilePicker()
+	if err != nil {
+		return joinFileSelectedMsg{path: ""}
+	}
+	return joinFileSelectedMsg{path: path}
+}
+
+// loadJoinFile loads the second file of a join in the background
+// It is read with the default and global null markers of the current table
+func (m AppModel) loadJoinFile(path string) (tea.Model, tea.Cmd) {
+	if path == "" {
+		m.statusText = "✗ No file selected"
+		return m, nil
+	}
+
+	nulls := m.dataTable.Nulls.ForOtherTable()
+	m.statusText = "⏳ Loading file to join..."
+	return m, func() tea.Msg {
+		table, err := file.LoadFile(path)
+		if err == nil {
+			table.Nulls = nulls
+		}
+		return joinFileLoadedMsg{path: path, err: err, dataTable: table}
+	}
+}
+
+// promptJoin asks for the join type, keys and suffix, then joins the loaded file
+func (m AppModel) promptJoin(msg joinFileLoadedMsg) AppModel {
+	if msg.err != nil {
+		m.statusText = fmt.Sprintf("✗ Failed to load: %v", msg.err)
+		return m
+	}
+
+	right := msg.dataTable
+	m.statusText = fmt.Sprintf("✓ Joining with %s (%d rows, %d columns)",
+		right.FileName, right.RowCount(), right.ColumnCount())
+
+	types := make([]string, len(reshaper.JoinTypes))
+	for i, t := range reshaper.JoinTypes {
+		types[i] = string(t)
+	}
+
+	m.openPrompt("JOIN "+right.FileName, "Join type ("+strings.Join(types, ", ")+"):", string(reshaper.JoinLeft), func(m AppModel, joinType string) AppModel {
+		opts := reshaper.JoinOptions{Type: reshaper.JoinType(strings.ToLower(strings.TrimSpace(joinType)))}
+
+		m.openPrompt("JOIN "+right.FileName, "Key columns (id or left=right, comma separated):", commonHeader(m.dataTable, right), func(m AppModel, keys string) AppModel {
+			var err error
+			if opts.LeftKeys, opts.RightKeys, err = reshaper.ParseJoinKeys(keys); err != nil {
+				m.statusText = fmt.Sprintf("✗ %v", err)
+				return m
+			}
+
+			m.openPrompt("JOIN "+right.FileName, "Suffix for clashing headers:", reshaper.DefaultJoinSuffix, func(m AppModel, suffix string) AppModel {
+				opts.RightSuffix = strings.TrimSpace(suffix)
+				return m.applyJoin(right, msg.path, opts)
+			})
+			return m
+		})
+		return m
+	})
+	return m
+}
+
+// applyJoin joins the current table with right and reports unmatched keys
+func (m AppModel) applyJoin(right *models.DataTable, path string, opts reshaper.JoinOptions) AppModel {
+	result, err := reshaper.Join(m.dataTable, right, opts)
+	if err != nil {
+		m.statusText = fmt.Sprintf("✗ Join failed: %v", err)
+		return m
+	}
+
+	m.dataTable = result.Table
+	m.scrollOffset = 0
+	m.columnOffset = 0
+	m.clearCellMarks()
+	m.statusText = fmt.Sprintf("✓ %s join with %s: %d rows, %d columns (%d matched, %d/%d unmatched keys left/right)",
+		opts.Type, right.FileName, result.Table.RowCount(), result.Table.ColumnCount(),
+		result.Matched, len(result.UnmatchedLeft), len(result.UnmatchedRight))
+	m.recordStep(recipe.OpJoin, recipe.JoinParams{File: path, JoinOptions: opts})
+	return m
+}
+
+// commonHeader returns the first header both tables share, used as the default key
+func commonHeader(left, right *models.DataTable) string {
+	for _, h := range left.Headers {
+		if right.ColumnIndex(h) != -1 {
+			return h
+		}
+	}
+	return ""
+}
+
+// openAppend asks for the files or open datasets to append and the source column name
+func (m AppModel) openAppend() (tea.Model, tea.Cmd) {
+	if m.dataTable == nil {
+		m.statusText = "⚠ No data loaded. Please load a file first."
+		return m, nil
+	}
+
+	pattern := "*.csv"
+	if m.dataTable.FilePath != "" {
+		pattern = filepath.Join(filepath.Dir(m.dataTable.FilePath), "*"+filepath.Ext(m.dataTable.FilePath))
+	}
+	label := "Files or glob patterns (comma separated):"
+	if len(m.datasets) > 1 {
+		tabs, first := m.otherTabs()
+		label = "Files, glob patterns or open datasets (" + tabs + "), comma separated:"
+		pattern = first
+	}
+
+	m.openPrompt("APPEND FILES", label, pattern, func(m AppModel, files string) AppModel {
+		patterns := splitHeaders(files)
+
+		m.openPrompt("APPEND FILES", "Source file column (empty for none):", "source_file", func(m AppModel, source string) AppModel {
+			opts := reshaper.AppendOptions{MatchNormalized: true, SourceColumn: strings.TrimSpace(source)}
+			return m.loadAppend(patterns, opts)
+		})
+		return m
+	})
+	return m, nil
+}
+
+// appendLoadedMsg carries the result of an append computed in the background
+type appendLoadedMsg struct {
+	left   *models.DataTable // Table the files were appended to
+	files  []string
+	opts   reshaper.AppendOptions
+	result reshaper.AppendResult
+	err    error
+}
+
+// loadAppend loads the matching files in the background and stacks them and
+// the chosen open datasets under the current table
+// Open datasets are recorded by their file, see tabTable
+func (m AppModel) loadAppend(patterns []string, opts reshaper.AppendOptions) AppModel {
+	left := m.dataTable
+	var open []*models.DataTable
+	var files, filePatterns []string
+	for _, pattern := range patterns {
+		table, ok, err := m.tabTable(pattern)
+		if err != nil {
+			m.statusText = fmt.Sprintf("✗ Append failed: %v", err)
+			return m
+		}
+		if ok {
+			open = append(open, table)
+			files = append(files, table.FilePath)
+		} else {
+			filePatterns = append(filePatterns, pattern)
+		}
+	}
+	files = append(files, filePatterns...)
+
+	m.statusText = "⏳ Loading files to append..."
+	m.promptCmd = func() tea.Msg {
+		tables := append([]*models.DataTable{left}, open...)
+		if len(filePatterns) > 0 {
+			loaded, err := reshaper.LoadAppendFiles(left, filePatterns, open...)
+			if err != nil {
+				return appendLoadedMsg{err: err}
+			}
+			tables = append(tables, loaded...)
+		}
+		result, err := reshaper.Append(tables, opts)
+		return appendLoadedMsg{left: left, files: files, opts: opts, result: result, err: err}
+	}
+	return m
+}
+
+// applyAppend shows the appended table unless the table changed while the files loaded
+func (m AppModel) applyAppend(msg appendLoadedMsg) AppModel {
+	if msg.err != nil {
+		m.statusText = fmt.Sprintf("✗ Append failed: %v", msg.err)
+		return m
+	}
+	if msg.left != m.dataTable {
+		m.statusText = "⚠ Append dropped: the table changed while the files were loading"
+		return m
+	}
+
+	result := msg.result
+	m.dataTable = result.Table
+	m.scrollOffset = 0
+	m.columnOffset = 0
+	m.clearCellMarks()
+	m.statusText = fmt.Sprintf("✓ Appended %d files: %d rows, %d columns",
+		len(result.Files)-1, result.Table.RowCount(), result.Table.ColumnCount())
+	if len(result.Mismatches) > 0 {
+		names := make([]string, len(result.Mismatches))
+		for i, mm := range result.Mismatches {
+			names[i] = mm.File
+		}
+		m.statusText += fmt.Sprintf(" (⚠ different headers: %s)", strings.Join(names, ", "))
+	}
+	m.recordStep(recipe.OpAppend, recipe.AppendParams{Files: msg.files, AppendOptions: msg.opts})
+	return m
+}
+
+// otherTabs lists the open datasets other than the shown one for a prompt label
+// and returns the tab number of the first as the default answer
+func (m AppModel) otherTabs() (string, string) {
+	var tabs []string
+	first := ""
+	for i, name := range m.datasetNames() {
+		if i == m.activeDataset {
+			continue
+		}
+		if first == "" {
+			first = strconv.Itoa(i + 1)
+		}
+		tabs = append(tabs, fmt.Sprintf("%d %s", i+1, name))
+	}
+	return strings.Join(tabs, ", "), first
+}
+
+// tabTable returns the table of the other open dataset whose tab number was entered
+// The join or append is recorded with the dataset's file, so a dataset with recorded
+// steps or without a file is refused: replaying the recipe would not see its table
+func (m AppModel) tabTable(value string) (*models.DataTable, bool, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || n < 1 || n > len(m.datasets) || n-1 == m.activeDataset {
+		return nil, false, nil
+	}
+
+	ws := m.datasets[n-1]
+	if ws.dataTable.FilePath == "" {
+		return nil, true, fmt.Errorf("dataset %d has no file a recipe could read it from", n)
+	}
+	if steps := len(ws.recipe.Steps); steps > 0 {
+		return nil, true, fmt.Errorf("dataset %d has %d recorded steps a recipe would not replay; export it and use the file instead", n, steps)
+	}
+	return ws.dataTable, true, nil
+}
@@ -0,0 +1,82 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+)
+
+// CheckPageSize is the number of issues listed per page
+const CheckPageSize = 10
+
+type CheckViewModel struct {
+	Result     cleaner.ValidationResult
+	Violations []cleaner.Violation
+	RuleCount  int
+	Issues     []cleaner.Issue // all issues including rule violations
+	Page       int
+	Message    string
+}
+
+// RenderCheck renders the QA check results
+func RenderCheck(vm CheckViewModel) string {
+	var b strings.Builder
+	r := vm.Result
+
+	b.WriteString(HeaderStyle.Render(" QA CHECKS "))
+	b.WriteString("\n\n")
+
+	total := fmt.Sprintf("Total issues: %d", r.TotalIssues)
+	if r.OutlierCount > 0 {
+		total += fmt.Sprintf("  ·  Possible outliers: %d", r.OutlierCount)
+	}
+	b.WriteString(TableInfoStyle.Render(total))
+	b.WriteString("\n\n")
+
+	lines := []string{
+		fmt.Sprintf("Duplicate rows:   %d", r.DuplicateCount),
+		fmt.Sprintf("Empty rows:       %d", r.EmptyRowCount),
+		fmt.Sprintf("Empty columns:    %d", r.EmptyColumnCount),
+		fmt.Sprintf("Missing values:   %d", r.MissingValueCount),
+		fmt.Sprintf("Outliers (IQR):   %d", r.OutlierCount),
+		fmt.Sprintf("Rule violations:  %d (%d rules)", len(vm.Violations), vm.RuleCount),
+	}
+	for _, line := range lines {
+		b.WriteString(TableCellStyle.Render(line))
+		b.WriteString("\n")
+	}
+
+	// Issue list, one page at a time
+	page, pages := cleaner.PageIssues(vm.Issues, vm.Page, CheckPageSize)
+	if pages > 0 {
+		b.WriteString("\n")
+		b.WriteString(SelectedStyle.Render(fmt.Sprintf(
+			"Issues: %d  (page %d/%d)", len(vm.Issues), min(vm.Page, pages-1)+1, pages,
+		)))
+		b.WriteString("\n")
+	}
+	for _, issue := range page {
+		location := "column"
+		if issue.Row >= 0 {
+			location = fmt.Sprintf("row %d", issue.Row+1)
+		}
+		if issue.Column != "" {
+			location += " " + issue.Column
+		}
+		b.WriteString(TableCellStyle.Render(fmt.Sprintf(
+			"  %-7s %-12s %-24s %s", issue.Severity, issue.Kind, location, issue.Message,
+		)))
+		b.WriteString("\n")
+	}
+
+	if vm.Message != "" {
+		b.WriteString("\n")
+		b.WriteString(TableInfoStyle.Render(vm.Message))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(TableHelpStyle.Render("Enter/v: View Highlighted Cells | ←/→: Page | e: Export Report | l: Load Rules | w: Save Rules | x: Clear Rules | b/Esc: Back | q: Quit"))
+
+	return TableBorderStyle.Render(b.String())
+}
@@ -20,10 +20,14 @@ func RenderCleaning(vm CleaningViewModel) string {
 		on    bool
 	}{
 		{"Trim whitespace (headers + cells)", vm.Options.TrimWhitespace},
+		{"Collapse repeated spaces inside cells", vm.Options.CollapseSpaces},
+		{"Remove invisible characters (NBSP, zero-width, control)", vm.Options.RemoveInvisible},
+		{"Unicode normalization (NFC)", vm.Options.NormalizeUnicode},
 		{"Normalize headers (lowercase, _)", vm.Options.NormalizeHeaders},
 		{"Remove empty rows", vm.Options.RemoveEmptyRows},
 		{"Remove empty columns", vm.Options.RemoveEmptyColumns},
 		{"Remove dublicate rows", vm.Options.RemoveDuplicates},
+		{"Standardize null markers (NULL, N/A, -, ...)", vm.Options.StandardizeNulls},
 	}
 
 	var (
@@ -7,8 +7,19 @@ import (
 	"github.com/veliulugut/snapclean/internal/models"
 )
 
+// ColumnMenuViewModel holds everything needed to render the column menu
+type ColumnMenuViewModel struct {
+	Data       *models.DataTable
+	Selected   int             // highlighted column
+	Marked     map[int]bool    // columns marked for a bulk operation
+	Hidden     map[string]bool // headers hidden from the table view
+	SwapSource int             // first column chosen for a swap (-1 if none)
+	Message    string
+}
+
 // RenderColumnMenu renders the column selection and management interface
-func RenderColumnMenu(dt *models.DataTable, selectedColumn int, message string) string {
+func RenderColumnMenu(vm ColumnMenuViewModel) string {
+	dt := vm.Data
 	if dt == nil || dt.IsEmpty() {
 		return ContainerStyle.Render("No data to display")
 	}
@@ -18,19 +29,28 @@ func RenderColumnMenu(dt *models.DataTable, selectedColumn int, message string)
 	title := HeaderStyle.Render(" COLUMN MANAGEMENT ")
 	output.WriteString(title + "\n\n")
 
-	if message != "" {
-		output.WriteString(SuccessMessageStyle.Render(message) + "\n\n")
+	if vm.Message != "" {
+		output.WriteString(SuccessMessageStyle.Render(vm.Message) + "\n\n")
 	}
 
-	output.WriteString(TableInfoStyle.Render(
-		fmt.Sprintf("Total Columns: %d  |  Select a column to swap with another", dt.ColumnCount()),
-	))
+	info := fmt.Sprintf("Total Columns: %d  |  Marked: %d  |  Space marks columns for Delete, Keep Only and Hide", dt.ColumnCount(), len(vm.Marked))
+	output.WriteString(TableInfoStyle.Render(info))
 	output.WriteString("\n\n")
 
-	// Display columns with selection indicator
+	// Display columns with selection, mark and visibility indicators
 	for i, header := range dt.Headers {
-		line := fmt.Sprintf("  [%2d] %s", i+1, header)
-		if i == selectedColumn {
+		mark := "[ ]"
+		if vm.Marked[i] {
+			mark = "[x]"
+		}
+		line := fmt.Sprintf("  %s [%2d] %s", mark, i+1, header)
+		if vm.Hidden[header] {
+			line += " (hidden)"
+		}
+		if i == vm.SwapSource {
+			line += " ↔"
+		}
+		if i == vm.Selected {
 			output.WriteString(SelectedStyle.Render("> "+line) + "\n")
 		} else {
 			output.WriteString(TableCellStyle.Render("  "+line) + "\n")
@@ -39,7 +59,7 @@ func RenderColumnMenu(dt *models.DataTable, selectedColumn int, message string)
 
 	output.WriteString("\n")
 	output.WriteString(TableHelpStyle.Render(
-		"↑/↓: Select Column  |  Enter: Choose Column to Swap  |  b/Esc: Back",
+		"↑/↓: Select Column  |  Space: Mark  |  Enter: Choose Column to Swap  |  D: Delete  |  O: Keep Only  |  R: Rename  |  C: Duplicate  |  K/J: Move Up/Down  |  M: Move To  |  H: Hide/Show  |  U: Undo  |  p: Profile  |  f: Fill Missing  |  u: Null Markers  |  d: Normalize Dates  |  n: Normalize Numbers  |  r: Find/Replace  |  s: Split  |  m: Merge  |  e: Expression  |  t: Change Case  |  v: Map Values  |  o: Outliers  |  a: Add Rule  |  x: Split Export  |  z: Sort ▲/▼/Off  |  b/Esc: Back",
 	))
 
 	return TableBorderStyle.Render(output.String())
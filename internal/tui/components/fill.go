@@ -0,0 +1,79 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+)
+
+type FillViewModel struct {
+	Column       string
+	MissingCount int
+	Strategy     cleaner.FillStrategy
+	GroupBy      string // empty when not grouping
+	Value        string // constant value
+	Editing      bool   // true while typing the constant value
+	Message      string
+}
+
+// RenderFill renders the missing value fill screen for one column
+func RenderFill(vm FillViewModel) string {
+	var b strings.Builder
+
+	b.WriteString(HeaderStyle.Render(" FILL MISSING VALUES "))
+	b.WriteString("\n\n")
+
+	b.WriteString(TableInfoStyle.Render(
+		fmt.Sprintf("Column: %s  |  Missing cells: %d", vm.Column, vm.MissingCount),
+	))
+	b.WriteString("\n\n")
+
+	// Strategy list
+	for _, s := range cleaner.FillStrategies {
+		line := fmt.Sprintf("( ) %s", s)
+		if s == vm.Strategy {
+			line = fmt.Sprintf("(•) %s", s)
+			b.WriteString(TableSelectedRowStyle.Render(line))
+		} else {
+			b.WriteString(TableCellStyle.Render(line))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	groupBy := vm.GroupBy
+	if groupBy == "" {
+		groupBy = "(none)"
+	}
+	b.WriteString(TableCellStyle.Render("Group by: " + groupBy))
+	b.WriteString("\n")
+
+	value := vm.Value
+	if vm.Editing {
+		value += "█"
+	}
+	valueLine := "Constant value: " + value
+	if vm.Editing {
+		b.WriteString(SelectedStyle.Render(valueLine))
+	} else {
+		b.WriteString(TableCellStyle.Render(valueLine))
+	}
+	b.WriteString("\n")
+
+	if vm.Message != "" {
+		b.WriteString("\n")
+		b.WriteString(TableInfoStyle.Render(vm.Message))
+	}
+
+	b.WriteString("\n")
+	if vm.Editing {
+		b.WriteString(TableHelpStyle.Render("Type value | Enter: Done | Esc: Cancel"))
+	} else {
+		b.WriteString(TableHelpStyle.Render(
+			"↑/↓: Strategy | g: Group By | v: Edit Value | Enter: Apply | i: Add Indicator Columns | b/Esc: Back",
+		))
+	}
+
+	return TableBorderStyle.Render(b.String())
+}
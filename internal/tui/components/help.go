@@ -33,9 +33,39 @@ func RenderHelp() string {
 	output.WriteString("\n")
 	output.WriteString(HelpTextStyle.Render("CLEAN   Remove empty rows/columns, normalize names"))
 	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("FILL    Impute missing values (View → c → f)"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("MAP     Merge spellings of the same value (View → c → v)"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("SORT    Multi-column sort (View → s, apply with S; View → c → z per column)"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("FILTER  Show matching rows (View → f, keep them with F); in(), matches(), isnull()"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("COLUMNS Delete, keep, rename, duplicate, move or hide columns (View → c)"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("SEARCH  Find cells in all or one column, n/N between matches (View → /, g: go to row)"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("LAYOUT  Table fits the window; p freezes columns, -/+ sets column width"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("INSPECT Full row as field list with types and issues (View → i)"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("EDIT    Fix single cells, insert or delete rows (View → Enter, o/O, D)"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("UNDO    Revert the last change to the data (View → U or Ctrl+Z)"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("TABS    LOAD opens another file in a new tab; Tab/Shift+Tab switch, Ctrl+W closes (View)"))
+	output.WriteString("\n")
 	output.WriteString(HelpTextStyle.Render("PIVOT   Create summary tables and aggregations"))
 	output.WriteString("\n")
-	output.WriteString(HelpTextStyle.Render("CHECK   Run duplicate and missing value checks"))
+	output.WriteString(HelpTextStyle.Render("CHECK   Run duplicate, missing, outlier and rule checks"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("RECIPE  Save applied steps and replay them on other files"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("JOIN    Merge another file or open dataset on key columns (inner/left/right/outer/anti)"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("APPEND  Stack monthly files or open datasets into one table, aligning columns by header"))
+	output.WriteString("\n")
+	output.WriteString(HelpTextStyle.Render("SPLIT   Write one file or sheet per value or row chunk (View → c → x)"))
 	output.WriteString("\n")
 	output.WriteString(HelpTextStyle.Render("SAVE    Export cleaned data as CSV or Excel"))
 	output.WriteString("\n\n")
@@ -0,0 +1,164 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// InspectorViewModel holds everything needed to render the row inspector
+type InspectorViewModel struct {
+	Data       *models.DataTable
+	Row        int             // data row shown
+	Position   int             // position of the row in the table view
+	TotalRows  int             // rows in the table view
+	Selected   int             // column index of the selected field
+	ValueTypes []string        // inferred type of each value of the row
+	Types      []string        // inferred type of each column
+	Issues     []cleaner.Issue // issues of the row from the last check
+	Checked    bool            // false when no check has run yet
+	Hidden     map[string]bool // headers hidden from the table view
+	Width      int             // terminal width
+	Height     int             // terminal height
+}
+
+// RenderInspector renders the current row as a field/value list with details of the selected field
+func RenderInspector(vm InspectorViewModel) string {
+	dt := vm.Data
+	if dt == nil || vm.Row < 0 || vm.Row >= dt.RowCount() {
+		return ContainerStyle.Render("No row to inspect")
+	}
+
+	width := tableWidth(vm.Width) - tableFrameWidth
+	var b strings.Builder
+
+	b.WriteString(HeaderStyle.Render(fmt.Sprintf(" ROW %d ", vm.Row+1)))
+	b.WriteString("\n\n")
+	b.WriteString(TableInfoStyle.Render(fmt.Sprintf("Row %d of %d in view  |  %d fields  |  File: %s",
+		vm.Position+1, vm.TotalRows, dt.ColumnCount(), dt.FileName)))
+	b.WriteString("\n\n")
+
+	// Row-level issues apply to every field
+	fieldIssues := make(map[string][]cleaner.Issue)
+	for _, issue := range vm.Issues {
+		if issue.Column == "" {
+			b.WriteString(TableHighlightCellStyle.Render(issueLine(issue)))
+			b.WriteString("\n")
+			continue
+		}
+		fieldIssues[issue.Column] = append(fieldIssues[issue.Column], issue)
+	}
+
+	// Field list, scrolled to keep the selected field visible
+	nameWidth := 0
+	for _, header := range dt.Headers {
+		nameWidth = max(nameWidth, runewidth.StringWidth(header))
+	}
+	nameWidth = min(nameWidth, 24)
+	valueWidth := max(10, width-nameWidth-6)
+
+	row := dt.Rows[vm.Row]
+	listSize := max(3, tableHeight(vm.Height)/2-4)
+	start := max(0, min(vm.Selected-listSize/2, dt.ColumnCount()-listSize))
+	end := min(dt.ColumnCount(), start+listSize)
+	for colIdx := start; colIdx < end; colIdx++ {
+		header := dt.Headers[colIdx]
+		mark := " "
+		if len(fieldIssues[header]) > 0 {
+			mark = "!"
+		}
+		line := fmt.Sprintf("%s %s │ %s", mark, fitText(header, nameWidth), fitText(cellValue(row, colIdx), valueWidth))
+		switch {
+		case colIdx == vm.Selected:
+			b.WriteString(TableCursorStyle.Render(line))
+		case vm.Hidden[header]:
+			b.WriteString(TableHelpStyle.UnsetMarginTop().Render(line))
+		default:
+			b.WriteString(TableCellStyle.Render(line))
+		}
+		b.WriteString("\n")
+	}
+	if start > 0 || end < dt.ColumnCount() {
+		b.WriteString(TableInfoStyle.Render(fmt.Sprintf("Fields %d-%d of %d", start+1, end, dt.ColumnCount())))
+		b.WriteString("\n")
+	}
+
+	// Details of the selected field
+	header := dt.Headers[vm.Selected]
+	value := cellValue(row, vm.Selected)
+	b.WriteString("\n")
+	title := header
+	if vm.Hidden[header] {
+		title += " (hidden in table)"
+	}
+	b.WriteString(SelectedStyle.Render(title))
+	b.WriteString("\n")
+
+	details := lipgloss.NewStyle().Width(width).Render(value)
+	if value == "" {
+		details = "(empty)"
+	}
+	b.WriteString(TableCellStyle.Render(details))
+	b.WriteString("\n")
+
+	info := fmt.Sprintf("Type: %s  |  Column type: %s  |  Length: %d characters",
+		typeAt(vm.ValueTypes, vm.Selected), typeAt(vm.Types, vm.Selected), len([]rune(value)))
+	if dt.IsMissing(vm.Selected, value) {
+		info += "  |  Missing"
+	}
+	b.WriteString(TableInfoStyle.Render(info))
+	b.WriteString("\n")
+
+	switch {
+	case len(fieldIssues[header]) > 0:
+		for _, issue := range fieldIssues[header] {
+			b.WriteString(TableHighlightCellStyle.Render(issueLine(issue)))
+			b.WriteString("\n")
+		}
+	case !vm.Checked:
+		b.WriteString(TableCellStyle.Render("Run CHECK from the menu to see validation issues."))
+		b.WriteString("\n")
+	default:
+		b.WriteString(SuccessMessageStyle.Render("✓ No issues"))
+		b.WriteString("\n")
+	}
+
+	b.WriteString(TableHelpStyle.Width(width).Render(
+		"↑/↓: Field  |  ←/→: Previous/Next Row  |  e: Edit Field  |  i/Esc: Close  |  q: Quit",
+	))
+
+	return TableBorderStyle.Render(b.String())
+}
+
+// tableHeight returns the terminal height, or a default before it is known
+func tableHeight(height int) int {
+	if height <= 0 {
+		return 30
+	}
+	return height
+}
+
+// cellValue returns the i-th cell of a row, empty when the row is short
+func cellValue(row []string, i int) string {
+	if i < len(row) {
+		return row[i]
+	}
+	return ""
+}
+
+// typeAt returns the i-th type, or "?" when types are not known
+func typeAt(types []string, i int) string {
+	if i < len(types) {
+		return types[i]
+	}
+	return "?"
+}
+
+// issueLine formats an issue for the inspector
+func issueLine(issue cleaner.Issue) string {
+	return fmt.Sprintf("⚠ %s %s: %s", issue.Severity, issue.Kind, issue.Message)
+}
@@ -0,0 +1,67 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+)
+
+type MappingViewModel struct {
+	Column   string
+	Values   []cleaner.ValueCount
+	Targets  map[string]string // pending value -> canonical value
+	Marked   map[string]bool
+	Cursor   int
+	PageSize int
+	Message  string
+}
+
+// RenderMapping renders the distinct values of a column with their pending mappings
+func RenderMapping(vm MappingViewModel) string {
+	var b strings.Builder
+
+	b.WriteString(HeaderStyle.Render(" MAP VALUES "))
+	b.WriteString("\n\n")
+
+	b.WriteString(TableInfoStyle.Render(
+		fmt.Sprintf("Column: %s  |  Distinct values: %d  |  Mapped: %d", vm.Column, len(vm.Values), len(vm.Targets)),
+	))
+	b.WriteString("\n\n")
+
+	// Keep the cursor inside the visible window
+	pageSize := max(vm.PageSize, 1)
+	start := max(0, vm.Cursor-pageSize+1)
+	end := min(len(vm.Values), start+pageSize)
+
+	for i := start; i < end; i++ {
+		v := vm.Values[i]
+		label := v.Value
+		if label == "" {
+			label = "(empty)"
+		}
+		line := fmt.Sprintf("%s %-30s %6d", checkbox(vm.Marked[v.Value]), label, v.Count)
+		if to, ok := vm.Targets[v.Value]; ok {
+			line += "  → " + to
+		}
+		if i == vm.Cursor {
+			b.WriteString(TableSelectedRowStyle.Render(line))
+		} else {
+			b.WriteString(TableCellStyle.Render(line))
+		}
+		b.WriteString("\n")
+	}
+
+	if vm.Message != "" {
+		b.WriteString("\n")
+		b.WriteString(TableInfoStyle.Render(vm.Message))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(TableHelpStyle.Render(
+		"↑/↓: Move | Space: Mark | m: Map To | x: Unmap | g: Suggest Clusters | Enter: Apply | w: Save Mapping | b/Esc: Back",
+	))
+
+	return TableBorderStyle.Render(b.String())
+}
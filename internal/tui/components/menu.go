@@ -6,7 +6,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-func RenderMenu(selectedItem int, options []string, statusText string) string {
+func RenderMenu(selectedItem int, options []string, statusText string, datasets []string, active int) string {
 	var output strings.Builder
 
 	// Header
@@ -34,6 +34,13 @@ func RenderMenu(selectedItem int, options []string, statusText string) string {
 		output.WriteString("\n")
 	}
 
+	// Open datasets
+	if len(datasets) > 1 {
+		output.WriteString("\n")
+		output.WriteString(RenderTabs(datasets, active, 90))
+		output.WriteString("\n")
+	}
+
 	// Status bar
 	if statusText != "" {
 		output.WriteString("\n")
@@ -45,7 +52,11 @@ func RenderMenu(selectedItem int, options []string, statusText string) string {
 
 	// Help text
 	output.WriteString("\n")
-	helpText := HelpStyle.Render("↑/↓: Navigate  |  Enter: Select  |  ?: Help  |  q: Quit")
+	help := "↑/↓: Navigate  |  Enter: Select  |  ?: Help  |  q: Quit"
+	if len(datasets) > 1 {
+		help = "↑/↓: Navigate  |  Enter: Select  |  Tab: Next Dataset  |  ?: Help  |  q: Quit"
+	}
+	helpText := HelpStyle.Render(help)
 	output.WriteString(helpText)
 
 	return lipgloss.Place(
@@ -0,0 +1,76 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/profiler"
+)
+
+type ProfileViewModel struct {
+	Profile profiler.ColumnProfile
+	Index   int // column position, 0-based
+	Total   int // number of columns
+}
+
+// RenderProfile renders the profile of one column
+func RenderProfile(vm ProfileViewModel) string {
+	var b strings.Builder
+	p := vm.Profile
+
+	b.WriteString(HeaderStyle.Render(" COLUMN PROFILE "))
+	b.WriteString("\n\n")
+
+	b.WriteString(TableInfoStyle.Render(
+		fmt.Sprintf("Column %d of %d: %s  |  Type: %s", vm.Index+1, vm.Total, p.Name, p.Type),
+	))
+	b.WriteString("\n\n")
+
+	lines := []string{
+		fmt.Sprintf("Values:     %d", p.Count),
+		fmt.Sprintf("Missing:    %d", p.Missing),
+		fmt.Sprintf("Distinct:   %d", p.Distinct),
+		fmt.Sprintf("Length:     %d .. %d characters", p.MinLength, p.MaxLength),
+	}
+	if n := p.Numeric; n != nil {
+		lines = append(lines,
+			fmt.Sprintf("Min / Max:  %s / %s", formatStat(n.Min), formatStat(n.Max)),
+			fmt.Sprintf("Mean:       %s", formatStat(n.Mean)),
+			fmt.Sprintf("Median:     %s", formatStat(n.Median)),
+			fmt.Sprintf("Std dev:    %s", formatStat(n.Std)),
+		)
+	}
+	for _, line := range lines {
+		b.WriteString(TableCellStyle.Render(line))
+		b.WriteString("\n")
+	}
+
+	if len(p.TopValues) > 0 {
+		b.WriteString("\n")
+		b.WriteString(SelectedStyle.Render("Top values"))
+		b.WriteString("\n")
+		for _, v := range p.TopValues {
+			share := float64(v.Count) / float64(max(p.Count, 1)) * 100
+			b.WriteString(TableCellStyle.Render(fmt.Sprintf("%-30s %6d  %5.1f%%", v.Value, v.Count, share)))
+			b.WriteString("\n")
+		}
+	}
+
+	if len(p.Samples) > 0 {
+		b.WriteString("\n")
+		b.WriteString(SelectedStyle.Render("Samples"))
+		b.WriteString("\n")
+		b.WriteString(TableCellStyle.Render(strings.Join(p.Samples, "  ·  ")))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(TableHelpStyle.Render("←/→: Previous/Next Column | b/Esc: Back | q: Quit"))
+
+	return TableBorderStyle.Render(b.String())
+}
+
+// formatStat formats a statistic with up to four decimals
+func formatStat(n float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.4f", n), "0"), ".")
+}
@@ -0,0 +1,24 @@
+package components
+
+import "strings"
+
+type PromptViewModel struct {
+	Title string
+	Label string
+	Value string
+}
+
+// RenderPrompt renders a single-line text input dialog
+func RenderPrompt(vm PromptViewModel) string {
+	var b strings.Builder
+
+	b.WriteString(HeaderStyle.Render(" " + vm.Title + " "))
+	b.WriteString("\n\n")
+	b.WriteString(TableCellStyle.Render(vm.Label))
+	b.WriteString("\n\n")
+	b.WriteString(SelectedStyle.Render("> " + vm.Value + "█"))
+	b.WriteString("\n")
+	b.WriteString(TableHelpStyle.Render("Enter: Confirm | Esc: Cancel"))
+
+	return TableBorderStyle.Render(b.String())
+}
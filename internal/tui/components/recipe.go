@@ -0,0 +1,61 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/recipe"
+)
+
+type RecipeViewModel struct {
+	Recipe  recipe.Recipe
+	Message string
+}
+
+// RenderRecipe renders the recorded cleaning steps
+func RenderRecipe(vm RecipeViewModel) string {
+	var b strings.Builder
+
+	b.WriteString(HeaderStyle.Render(" RECIPE "))
+	b.WriteString("\n\n")
+
+	manual := 0
+	for _, step := range vm.Recipe.Steps {
+		if recipe.IsManual(step.Op) {
+			manual++
+		}
+	}
+	info := fmt.Sprintf("Recorded steps: %d", len(vm.Recipe.Steps))
+	if manual > 0 {
+		info += fmt.Sprintf("  |  Manual edits: %d (✎, replay only on the same rows)", manual)
+	}
+	b.WriteString(TableInfoStyle.Render(info))
+	b.WriteString("\n\n")
+
+	if len(vm.Recipe.Steps) == 0 {
+		b.WriteString(TableCellStyle.Render("No steps recorded yet. Clean, fill or edit columns to record steps."))
+		b.WriteString("\n")
+	}
+	for i, step := range vm.Recipe.Steps {
+		params := string(step.Params)
+		if len(params) > 60 {
+			params = params[:57] + "..."
+		}
+		style, mark := TableCellStyle, " "
+		if recipe.IsManual(step.Op) {
+			style, mark = TableHighlightCellStyle, "✎"
+		}
+		b.WriteString(style.Render(fmt.Sprintf("%2d.%s %-18s %s", i+1, mark, step.Op, params)))
+		b.WriteString("\n")
+	}
+
+	if vm.Message != "" {
+		b.WriteString("\n")
+		b.WriteString(TableInfoStyle.Render(vm.Message))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(TableHelpStyle.Render("s: Save to File | a: Apply Recipe File | x: Clear | b/Esc: Back | q: Quit"))
+
+	return TableBorderStyle.Render(b.String())
+}
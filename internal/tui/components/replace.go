@@ -0,0 +1,92 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+)
+
+// Replace form fields in focus order
+const (
+	ReplaceFieldFind = iota
+	ReplaceFieldReplace
+	ReplaceFieldColumns
+	ReplaceFieldRegex
+	ReplaceFieldIgnoreCase
+	ReplaceFieldWholeCell
+	ReplaceFieldCount
+)
+
+type ReplaceViewModel struct {
+	Options cleaner.ReplaceOptions
+	Columns string // comma separated headers as typed
+	Focus   int
+	Preview cleaner.ReplacePreview
+	Error   string
+	Message string
+}
+
+// RenderReplace renders the find/replace form with a live preview
+func RenderReplace(vm ReplaceViewModel) string {
+	var b strings.Builder
+
+	b.WriteString(HeaderStyle.Render(" FIND & REPLACE "))
+	b.WriteString("\n\n")
+
+	fields := []string{
+		"Find:     " + vm.Options.Find,
+		"Replace:  " + vm.Options.Replace,
+		"Columns:  " + vm.Columns,
+		checkbox(vm.Options.Regex) + " Regular expression",
+		checkbox(vm.Options.IgnoreCase) + " Ignore case",
+		checkbox(vm.Options.WholeCell) + " Whole cell only",
+	}
+	for i, field := range fields {
+		if i == vm.Focus {
+			if i <= ReplaceFieldColumns {
+				field += "█"
+			}
+			b.WriteString(TableSelectedRowStyle.Render(field))
+		} else {
+			b.WriteString(TableCellStyle.Render(field))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(TableHelpStyle.Render("Columns: comma separated headers, empty for all"))
+	b.WriteString("\n\n")
+
+	// Preview
+	if vm.Error != "" {
+		b.WriteString(TableHighlightCellStyle.Render("✗ " + vm.Error))
+		b.WriteString("\n")
+	} else if vm.Options.Find != "" {
+		b.WriteString(TableInfoStyle.Render(
+			fmt.Sprintf("Preview: %d matches in %d cells", vm.Preview.Matches, vm.Preview.Cells),
+		))
+		b.WriteString("\n")
+		for _, s := range vm.Preview.Samples {
+			b.WriteString(TableCellStyle.Render(
+				fmt.Sprintf("row %d, %s: %q → %q", s.Row+1, s.Column, s.Before, s.After),
+			))
+			b.WriteString("\n")
+		}
+	}
+
+	if vm.Message != "" {
+		b.WriteString("\n")
+		b.WriteString(TableInfoStyle.Render(vm.Message))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(TableHelpStyle.Render("Tab/↑/↓: Field | Space: Toggle | Enter: Apply | Esc: Back"))
+
+	return TableBorderStyle.Render(b.String())
+}
+
+func checkbox(on bool) string {
+	if on {
+		return "[x]"
+	}
+	return "[ ]"
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
 	"github.com/veliulugut/snapclean/internal/models"
 )
 
@@ -38,10 +39,154 @@ var (
 	TableHelpStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#8787AF")).
 			MarginTop(1)
+
+	// TableHighlightCellStyle marks cells of interest (e.g. imputed values)
+	TableHighlightCellStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#FFD75F")).
+				Bold(true).
+				Padding(0, 1)
+
+	// TableMatchStyle marks cells matching the search
+	TableMatchStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#000000")).
+			Background(lipgloss.Color("#FFD75F")).
+			Padding(0, 1)
+
+	// TableCursorStyle marks the cell under the cursor
+	TableCursorStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#000000")).
+				Background(lipgloss.Color("#AF87FF")).
+				Padding(0, 1)
+)
+
+const (
+	TableMinColumnWidth = 3   // narrowest width a column can be set to
+	TableMaxColumnWidth = 30  // widest automatic width; wider values are truncated
+	TableMinPageSize    = 3   // fewest rows shown on small terminals
+	tableDefaultWidth   = 120 // terminal width assumed before the first resize message
+	tableFrameWidth     = 6   // border and horizontal padding of TableBorderStyle
+	tableCellPadding    = 2   // horizontal padding of the cell styles
+	tableSeparator      = " │ "
 )
 
+// tableHelp lists the key bindings of the table view
+const tableHelp = "↑/↓/←/→: Move  |  PgUp/PgDn: Page  |  Enter/e: Edit Cell  |  o/O: Insert Row Below/Above  |  D: Delete Row  |  i: Inspect Row  |  /: Search  |  n/N: Next/Prev Match or Issue  |  g: Go to Row  |  f/F: Filter/Apply  |  s/S: Sort/Apply  |  p: Freeze Columns  |  -/+: Column Width  |  r: Find/Replace  |  c: Column Menu  |  Tab: Next Dataset  |  Ctrl+W: Close Dataset  |  U: Undo  |  b/Esc: Back  |  q: Quit"
+
+// TableViewModel holds everything needed to render the table view
+type TableViewModel struct {
+	Data         *models.DataTable
+	ScrollOffset int                     // first visible row
+	ColumnOffset int                     // first scrolled column, a position in Columns
+	PageSize     int                     // number of rows per page
+	Width        int                     // terminal width
+	Frozen       int                     // columns that stay in place while scrolling horizontally
+	FrozenStart  int                     // position in Columns of the first frozen column
+	ColumnWidths map[string]int          // widths set by the user, by header
+	Highlights   map[models.CellRef]bool // cells rendered with TableHighlightCellStyle
+	RowIndices   []int                   // rows to show, in order, when a view filter or sort is active (nil shows all)
+	Columns      []int                   // columns to show, in order, when some are hidden (nil shows all)
+	FilterLabel  string                  // active view filter, shown in the info line
+	SortLabel    string                  // active view sort, shown in the info line
+	SortMarkers  map[int]string          // header suffixes of sorted columns (e.g. "▲1")
+	Matches      map[models.CellRef]bool // cells rendered with TableMatchStyle
+	SearchLabel  string                  // search query and match count, shown in the info line
+	Focus        *models.CellRef         // cell rendered with TableSelectedRowStyle (e.g. current issue)
+	Cursor       *models.CellRef         // cell rendered with TableCursorStyle
+	Editing      bool                    // true while the cursor cell is being edited
+	EditValue    string                  // value shown in the cursor cell while editing
+	Message      string                  // shown above the table
+	Tabs         []string                // file names of the open datasets, shown instead of the title when more than one
+	ActiveTab    int                     // position of the shown dataset in Tabs
+}
+
+// TableLayout is the part of the table that fits the terminal width
+type TableLayout struct {
+	Columns     []int // data column indexes to draw, frozen ones first
+	Widths      []int // display width of each drawn column
+	Frozen      int   // number of frozen columns drawn at the start of Columns
+	FrozenStart int   // position of the first frozen column
+	Start       int   // position of the first scrolled column shown
+	End         int   // position after the last scrolled column shown
+	Total       int   // number of columns that can be shown
+}
+
+// IsFrozen reports whether the column at a position is drawn as a frozen column
+func (l TableLayout) IsFrozen(pos int) bool {
+	return pos >= l.FrozenStart && pos < l.FrozenStart+l.Frozen
+}
+
+// LayoutTable picks the columns that fit the terminal width
+// Frozen columns always come first; scrolled columns follow from ColumnOffset, skipping them
+// Frozen columns that do not fit are scrolled like the others
+// At least one scrolled column is shown, even if it does not fit
+func LayoutTable(vm TableViewModel) TableLayout {
+	dt := vm.Data
+	columns := vm.Columns
+	if columns == nil && dt != nil {
+		columns = make([]int, dt.ColumnCount())
+		for i := range columns {
+			columns[i] = i
+		}
+	}
+
+	layout := TableLayout{Total: len(columns), FrozenStart: min(max(vm.FrozenStart, 0), len(columns))}
+	frozen := min(max(vm.Frozen, 0), len(columns)-layout.FrozenStart)
+	available := tableWidth(vm.Width) - tableFrameWidth
+	used := 0
+	add := func(pos int, force bool) bool {
+		colIdx := columns[pos]
+		width := ColumnWidth(vm, colIdx)
+		cost := width + tableCellPadding
+		if len(layout.Columns) > 0 {
+			cost += runewidth.StringWidth(tableSeparator)
+		}
+		if used+cost > available && !force {
+			return false
+		}
+		used += cost
+		layout.Columns = append(layout.Columns, colIdx)
+		layout.Widths = append(layout.Widths, width)
+		return true
+	}
+
+	for pos := layout.FrozenStart; pos < layout.FrozenStart+frozen; pos++ {
+		if !add(pos, pos == layout.FrozenStart) {
+			break
+		}
+	}
+	layout.Frozen = len(layout.Columns)
+
+	layout.Start = min(max(vm.ColumnOffset, 0), len(columns))
+	if layout.IsFrozen(layout.Start) {
+		layout.Start = layout.FrozenStart + layout.Frozen
+	}
+	layout.End = layout.Start
+	for layout.End < len(columns) {
+		if layout.IsFrozen(layout.End) {
+			layout.End++
+			continue
+		}
+		if !add(layout.End, len(layout.Columns) == layout.Frozen) {
+			break
+		}
+		layout.End++
+	}
+
+	return layout
+}
+
+// TablePageSize returns the number of rows that fit the terminal height
+func TablePageSize(width, height int) int {
+	help := lipgloss.Height(TableHelpStyle.Width(tableWidth(width) - tableFrameWidth).Render(tableHelp))
+	// Frame (4), title, info, message and header lines with their blank lines (8), footer lines (3)
+	return max(TableMinPageSize, height-15-help)
+}
+
 // RenderTable renders a data table with pagination and horizontal scroll
-func RenderTable(dt *models.DataTable, scrollOffset, columnOffset, pageSize, termWidth int) string {
+func RenderTable(vm TableViewModel) string {
+	dt := vm.Data
+	scrollOffset, pageSize := vm.ScrollOffset, vm.PageSize
+
 	if dt == nil || dt.IsEmpty() {
 		return ContainerStyle.Render("No data to display")
 	}
@@ -49,143 +194,187 @@ func RenderTable(dt *models.DataTable, scrollOffset, columnOffset, pageSize, ter
 	var output strings.Builder
 
 	title := HeaderStyle.Render(fmt.Sprintf(" DATA VIEW - %s ", dt.FileName))
+	if len(vm.Tabs) > 1 {
+		title = lipgloss.NewStyle().MarginBottom(1).Render(RenderTabs(vm.Tabs, vm.ActiveTab, vm.Width))
+	}
 	output.WriteString(title + "\n\n")
 
-	totalCols := dt.ColumnCount()
-	visibleColCount := 11 // Show 11 columns at a time
-	endCol := columnOffset + visibleColCount
-	if endCol > totalCols {
-		endCol = totalCols
+	layout := LayoutTable(vm)
+	columnRange := fmt.Sprintf("%d-%d", layout.Start+1, layout.End)
+	switch {
+	case layout.Frozen == 1:
+		columnRange = fmt.Sprintf("%d (frozen) + %s", layout.FrozenStart+1, columnRange)
+	case layout.Frozen > 1:
+		columnRange = fmt.Sprintf("%d-%d (frozen) + %s", layout.FrozenStart+1, layout.FrozenStart+layout.Frozen, columnRange)
 	}
-
-	info := TableInfoStyle.Render(fmt.Sprintf(
-		"Rows: %d  |  Columns: %d-%d of %d  |  File: %s",
+	info := fmt.Sprintf(
+		"Rows: %d  |  Columns: %s of %d  |  File: %s",
 		dt.RowCount(),
-		columnOffset+1,
-		endCol,
-		totalCols,
+		columnRange,
+		layout.Total,
 		dt.FileName,
-	))
-	output.WriteString(info + "\n\n")
+	)
+	if hidden := dt.ColumnCount() - layout.Total; hidden > 0 {
+		info += fmt.Sprintf("  |  %d hidden", hidden)
+	}
+	output.WriteString(TableInfoStyle.Render(info) + "\n\n")
 
-	// Get visible columns
-	visibleHeaders := getVisibleSlice(dt.Headers, columnOffset, visibleColCount)
-	colWidths := calculateColumnWidths(dt, columnOffset, visibleColCount)
+	if vm.Message != "" {
+		output.WriteString(SelectedStyle.Render(vm.Message) + "\n\n")
+	}
 
 	// Headers
-	headerRow := renderRow(visibleHeaders, colWidths, TableHeaderStyle)
-	output.WriteString(headerRow + "\n")
-	output.WriteString(strings.Repeat("─", sum(colWidths)+len(colWidths)*3) + "\n")
+	headers := make([]string, len(layout.Columns))
+	for i, colIdx := range layout.Columns {
+		headers[i] = headerLabel(vm, colIdx)
+	}
+	output.WriteString(renderRow(headers, layout, TableHeaderStyle) + "\n")
+	ruleWidth := sum(layout.Widths) + len(layout.Widths)*tableCellPadding + max(0, len(layout.Widths)-1)*runewidth.StringWidth(tableSeparator)
+	output.WriteString(strings.Repeat("─", ruleWidth) + "\n")
 
 	// Data rows
 	totalRows := dt.RowCount()
+	if vm.RowIndices != nil {
+		totalRows = len(vm.RowIndices)
+	}
 	endRow := scrollOffset + pageSize
 	if endRow > totalRows {
 		endRow = totalRows
 	}
-	for i := scrollOffset; i < endRow; i++ {
+	for pos := scrollOffset; pos < endRow; pos++ {
+		i := pos
+		if vm.RowIndices != nil {
+			i = vm.RowIndices[pos]
+		}
 		row, _ := dt.GetRow(i)
-		visibleCells := getVisibleSlice(row, columnOffset, visibleColCount)
-		output.WriteString(renderRow(visibleCells, colWidths, TableCellStyle) + "\n")
+		output.WriteString(renderDataRow(vm, i, row, layout) + "\n")
 	}
 
 	output.WriteString("\n")
 	output.WriteString(TableInfoStyle.Render(
 		fmt.Sprintf("Showing rows %d-%d of %d", scrollOffset+1, endRow, totalRows),
 	))
+	if vm.FilterLabel != "" {
+		output.WriteString("  ")
+		output.WriteString(SelectedStyle.Render(
+			fmt.Sprintf("Filter: %s (%d of %d rows)", vm.FilterLabel, totalRows, dt.RowCount()),
+		))
+	}
+	if vm.SortLabel != "" {
+		output.WriteString("  ")
+		output.WriteString(SelectedStyle.Render("Sort: " + vm.SortLabel))
+	}
+	if vm.SearchLabel != "" {
+		output.WriteString("  ")
+		output.WriteString(TableMatchStyle.Render("Search: " + vm.SearchLabel))
+	}
+	if len(vm.Highlights) > 0 {
+		output.WriteString("  ")
+		output.WriteString(TableHighlightCellStyle.Render(
+			fmt.Sprintf("%d highlighted cells", len(vm.Highlights)),
+		))
+	}
 
 	output.WriteString("\n")
-	output.WriteString(TableHelpStyle.Render(
-		"↑/↓: Rows  |  ←/→: Columns  |  PgUp/PgDn: Page  |  c: Column Menu  |  b/Esc: Back  |  q: Quit",
-	))
+	output.WriteString(TableHelpStyle.Width(tableWidth(vm.Width) - tableFrameWidth).Render(tableHelp))
 
 	return TableBorderStyle.Render(output.String())
 }
 
-// getVisibleSlice returns a slice of visible elements based on offset and count
-func getVisibleSlice(items []string, offset, count int) []string {
-	if offset >= len(items) {
-		return []string{}
-	}
-	end := offset + count
-	if end > len(items) {
-		end = len(items)
+// tableWidth returns the terminal width, or a default before it is known
+func tableWidth(width int) int {
+	if width <= 0 {
+		return tableDefaultWidth
 	}
-	return items[offset:end]
+	return width
 }
 
-// calculateColumnWidths calculates widths for visible columns only
-func calculateColumnWidths(dt *models.DataTable, colOffset, visibleCount int) []int {
-	totalCols := dt.ColumnCount()
-	endCol := colOffset + visibleCount
-	if endCol > totalCols {
-		endCol = totalCols
+// headerLabel returns the header of a column with its sort marker
+func headerLabel(vm TableViewModel, colIdx int) string {
+	header := vm.Data.Headers[colIdx]
+	if marker, ok := vm.SortMarkers[colIdx]; ok {
+		header += " " + marker
 	}
+	return header
+}
 
-	visibleRange := endCol - colOffset
-	if visibleRange <= 0 {
-		return []int{}
+// ColumnWidth returns the display width of a column: the user's width if set,
+// otherwise the widest of the header and the first 100 values, within limits
+func ColumnWidth(vm TableViewModel, colIdx int) int {
+	dt := vm.Data
+	if width, ok := vm.ColumnWidths[dt.Headers[colIdx]]; ok {
+		return max(width, TableMinColumnWidth)
 	}
 
-	widths := make([]int, visibleRange)
-
-	// Start with header widths
-	for i := 0; i < visibleRange; i++ {
-		colIdx := colOffset + i
-		if colIdx < len(dt.Headers) {
-			widths[i] = len(dt.Headers[colIdx])
+	width := runewidth.StringWidth(headerLabel(vm, colIdx))
+	limit := min(dt.RowCount(), 100)
+	for r := 0; r < limit; r++ {
+		if row := dt.Rows[r]; colIdx < len(row) {
+			width = max(width, runewidth.StringWidth(row[colIdx]))
 		}
 	}
 
-	// Check first 100 rows
-	limit := dt.RowCount()
-	if limit > 100 {
-		limit = 100
+	return min(max(width, 5), TableMaxColumnWidth)
+}
+
+// renderRow renders header cells with the layout's widths
+func renderRow(cells []string, layout TableLayout, style lipgloss.Style) string {
+	parts := make([]string, len(cells))
+	for i, cell := range cells {
+		parts[i] = style.Render(fitText(cell, layout.Widths[i]))
 	}
-	for r := 0; r < limit; r++ {
-		row, _ := dt.GetRow(r)
-		for i := 0; i < visibleRange; i++ {
-			colIdx := colOffset + i
-			if colIdx < len(row) && len(row[colIdx]) > widths[i] {
-				widths[i] = len(row[colIdx])
+	return joinCells(parts, layout)
+}
+
+// renderDataRow renders the visible cells of a data row, highlighting the cursor and marked cells
+func renderDataRow(vm TableViewModel, rowIdx int, row []string, layout TableLayout) string {
+	parts := make([]string, len(layout.Columns))
+	for i, colIdx := range layout.Columns {
+		style := TableCellStyle
+		cell := models.CellRef{Row: rowIdx, Col: colIdx}
+		text := fitText(cellValue(row, colIdx), layout.Widths[i])
+		switch {
+		case vm.Cursor != nil && *vm.Cursor == cell:
+			style = TableCursorStyle
+			if vm.Editing {
+				text = fitEditValue(vm.EditValue, layout.Widths[i])
 			}
+		case vm.Focus != nil && *vm.Focus == cell:
+			style = TableSelectedRowStyle
+		case vm.Matches[cell]:
+			style = TableMatchStyle
+		case vm.Highlights[cell]:
+			style = TableHighlightCellStyle
 		}
+		parts[i] = style.Render(text)
 	}
+	return joinCells(parts, layout)
+}
 
-	// Cap width at 15 chars, min 5
-	for i := range widths {
-		if widths[i] > 15 {
-			widths[i] = 15
-		}
-		if widths[i] < 5 {
-			widths[i] = 5
-		}
+// joinCells joins rendered cells, marking the edge of the frozen columns
+func joinCells(parts []string, layout TableLayout) string {
+	if layout.Frozen == 0 || layout.Frozen >= len(parts) {
+		return strings.Join(parts, tableSeparator)
 	}
-
-	return widths
+	return strings.Join(parts[:layout.Frozen], tableSeparator) + " ┃ " + strings.Join(parts[layout.Frozen:], tableSeparator)
 }
 
-func renderRow(cells []string, widths []int, style lipgloss.Style) string {
-	var parts []string
-	for i := 0; i < len(widths); i++ {
-		cell := ""
-		if i < len(cells) {
-			cell = cells[i]
-		}
-		if len(cell) > widths[i] {
-			cell = cell[:max(1, widths[i]-3)] + "..."
-		}
-		cell = padRight(cell, widths[i])
-		parts = append(parts, style.Render(cell))
+// fitText truncates and pads a value to the given display width
+// Wide runes (e.g. CJK) count as two columns, combining marks as none
+func fitText(text string, width int) string {
+	if runewidth.StringWidth(text) > width {
+		text = runewidth.Truncate(text, width, "…")
 	}
-	return strings.Join(parts, " │ ")
+	return runewidth.FillRight(text, width)
 }
 
-func padRight(s string, length int) string {
-	if len(s) >= length {
-		return s
+// fitEditValue shows the end of a value being typed, followed by a caret
+func fitEditValue(value string, width int) string {
+	runes := []rune(value + "▏")
+	for len(runes) > 1 && runewidth.StringWidth(string(runes)) > width {
+		runes = runes[1:]
 	}
-	return s + strings.Repeat(" ", length-len(s))
+	return runewidth.FillRight(string(runes), width)
 }
 
 func sum(nums []int) int {
@@ -0,0 +1,78 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+var (
+	// TabStyle renders the tab of an open dataset that is not shown
+	TabStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#BCBCBC")).
+			Background(lipgloss.Color("#3A3A3A")).
+			Padding(0, 1)
+
+	// ActiveTabStyle renders the tab of the dataset that is shown
+	ActiveTabStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#FFFFFF")).
+			Background(lipgloss.Color("#5F5FFF")).
+			Padding(0, 1)
+)
+
+// tabMaxNameWidth limits the file name shown on a tab
+const tabMaxNameWidth = 20
+
+// RenderTabs renders the open datasets as a single line of numbered tabs
+// Tabs that do not fit the width are left out around the active one and counted with ‹ and ›
+func RenderTabs(names []string, active, width int) string {
+	if len(names) == 0 {
+		return ""
+	}
+	active = max(0, min(active, len(names)-1))
+
+	tabs := make([]string, len(names))
+	for i, name := range names {
+		label := fmt.Sprintf("%d %s", i+1, runewidth.Truncate(name, tabMaxNameWidth, "…"))
+		if i == active {
+			tabs[i] = ActiveTabStyle.Render(label)
+		} else {
+			tabs[i] = TabStyle.Render(label)
+		}
+	}
+
+	// Grow the visible range from the active tab while it fits, leaving room for the markers
+	available := tableWidth(width) - tableFrameWidth - 8
+	start, end := active, active+1
+	used := lipgloss.Width(tabs[active])
+	for grown := true; grown; {
+		grown = false
+		if end < len(tabs) && used+1+lipgloss.Width(tabs[end]) <= available {
+			used += 1 + lipgloss.Width(tabs[end])
+			end++
+			grown = true
+		}
+		if start > 0 && used+1+lipgloss.Width(tabs[start-1]) <= available {
+			start--
+			used += 1 + lipgloss.Width(tabs[start])
+			grown = true
+		}
+	}
+
+	line := ""
+	if start > 0 {
+		line = TableInfoStyle.Render(fmt.Sprintf("‹%d ", start))
+	}
+	for i := start; i < end; i++ {
+		if i > start {
+			line += " "
+		}
+		line += tabs[i]
+	}
+	if end < len(tabs) {
+		line += TableInfoStyle.Render(fmt.Sprintf(" %d›", len(tabs)-end))
+	}
+	return line
+}
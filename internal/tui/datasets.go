@@ -0,0 +1,235 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/recipe"
+)
+
+// workspace is the state of one open dataset
+// The active dataset lives in the AppModel fields and is saved back when another one is shown
+type workspace struct {
+	dataTable  *models.DataTable
+	loadedFile string
+
+	// Table view state
+	scrollOffset  int
+	columnOffset  int
+	cursorRow     int
+	cursorCol     int
+	frozenColumns int
+	frozenStart   int
+	columnWidths  map[string]int
+
+	// View filter and sort, column state
+	viewFilter      string
+	viewSort        []cleaner.SortKey
+	viewRows        []int
+	viewFilterTable *models.DataTable
+	hiddenColumns   map[string]bool
+	columnMarks     map[int]bool
+
+	// Undo history and recorded steps
+	history []snapshot
+	recipe  recipe.Recipe
+
+	// Highlighted cells and check results
+	imputed         []models.CellRef
+	flagged         []models.CellRef
+	edited          []models.CellRef
+	checkResult     cleaner.ValidationResult
+	checkViolations []cleaner.Violation
+	issues          []cleaner.Issue
+	issueIndex      int
+	issuePage       int
+}
+
+// newWorkspace returns the state of a dataset that was just opened
+func newWorkspace() workspace {
+	return workspace{issueIndex: -1}
+}
+
+// saveWorkspace stores the active dataset's state in its slot
+func (m *AppModel) saveWorkspace() {
+	if m.activeDataset >= len(m.datasets) {
+		return
+	}
+	m.datasets[m.activeDataset] = workspace{
+		dataTable:       m.dataTable,
+		loadedFile:      m.loadedFile,
+		scrollOffset:    m.scrollOffset,
+		columnOffset:    m.columnOffset,
+		cursorRow:       m.cursorRow,
+		cursorCol:       m.cursorCol,
+		frozenColumns:   m.frozenColumns,
+		frozenStart:     m.frozenStart,
+		columnWidths:    m.columnWidths,
+		viewFilter:      m.viewFilter,
+		viewSort:        m.viewSort,
+		viewRows:        m.viewRows,
+		viewFilterTable: m.viewFilterTable,
+		hiddenColumns:   m.hiddenColumns,
+		columnMarks:     m.columnMarks,
+		history:         m.history,
+		recipe:          m.recipe,
+		imputed:         m.imputed,
+		flagged:         m.flagged,
+		edited:          m.edited,
+		checkResult:     m.checkResult,
+		checkViolations: m.checkViolations,
+		issues:          m.issues,
+		issueIndex:      m.issueIndex,
+		issuePage:       m.issuePage,
+	}
+}
+
+// restoreWorkspace makes a dataset's state the active one
+// Search, inspector and column menu state belong to the dataset that was shown and are dropped
+func (m *AppModel) restoreWorkspace(ws workspace) {
+	m.dataTable = ws.dataTable
+	m.loadedFile = ws.loadedFile
+	m.scrollOffset = ws.scrollOffset
+	m.columnOffset = ws.columnOffset
+	m.cursorRow = ws.cursorRow
+	m.cursorCol = ws.cursorCol
+	m.frozenColumns = ws.frozenColumns
+	m.frozenStart = ws.frozenStart
+	m.columnWidths = ws.columnWidths
+	m.viewFilter = ws.viewFilter
+	m.viewSort = ws.viewSort
+	m.viewRows = ws.viewRows
+	m.viewFilterTable = ws.viewFilterTable
+	m.hiddenColumns = ws.hiddenColumns
+	m.columnMarks = ws.columnMarks
+	m.history = ws.history
+	m.recipe = ws.recipe
+	m.imputed = ws.imputed
+	m.flagged = ws.flagged
+	m.edited = ws.edited
+	m.checkResult = ws.checkResult
+	m.checkViolations = ws.checkViolations
+	m.issues = ws.issues
+	m.issueIndex = ws.issueIndex
+	m.issuePage = ws.issuePage
+
+	m.clearSearch()
+	m.inspecting = false
+	m.columnMenuMode = false
+	m.selectedColumn = 0
+	m.swapSourceCol = -1
+	m.tableMessage = ""
+}
+
+// openDataset shows a loaded table, in a new tab when another dataset is already open
+func (m *AppModel) openDataset(dt *models.DataTable, path string) {
+	if m.dataTable != nil {
+		m.saveWorkspace()
+		m.datasets = append(m.datasets, workspace{})
+		m.activeDataset = len(m.datasets) - 1
+		m.restoreWorkspace(newWorkspace())
+	} else if len(m.datasets) == 0 {
+		m.datasets = []workspace{newWorkspace()}
+		m.activeDataset = 0
+	}
+
+	m.dataTable = dt
+	m.dataTable.Nulls = models.DefaultNullMarkers()
+	m.loadedFile = path
+	m.scrollOffset = 0
+	m.columnOffset = 0
+	m.clearCellMarks()
+	m.history = nil
+	m.hiddenColumns = nil
+	m.columnMarks = nil
+	m.cursorRow, m.cursorCol = 0, 0
+	m.frozenColumns, m.frozenStart = 0, 0
+	m.columnWidths = nil
+	m.inspecting = false
+	m.clearSearch()
+}
+
+// switchDataset shows the i-th open dataset
+func (m *AppModel) switchDataset(i int) {
+	if i < 0 || i >= len(m.datasets) || i == m.activeDataset {
+		return
+	}
+	m.saveWorkspace()
+	m.activeDataset = i
+	m.restoreWorkspace(m.datasets[i])
+	m.statusText = fmt.Sprintf("Dataset %d of %d: %s", i+1, len(m.datasets), m.dataTable.FileName)
+}
+
+// cycleDataset shows the next (step 1) or previous (step -1) open dataset
+func (m *AppModel) cycleDataset(step int) {
+	n := len(m.datasets)
+	if n == 0 {
+		m.statusText = "⚠ No data loaded. Please load a file first."
+		return
+	}
+	if n < 2 {
+		m.statusText = "⚠ Only one dataset is open. Load another file from the menu to open it in a new tab."
+		m.tableMessage = m.statusText
+		return
+	}
+	m.switchDataset((m.activeDataset + step + n) % n)
+	m.tableMessage = m.statusText
+}
+
+// openCloseDataset closes the active dataset, asking first when it has recorded steps
+func (m *AppModel) openCloseDataset() {
+	steps := len(m.recipe.Steps)
+	if steps == 0 {
+		m.closeDataset()
+		return
+	}
+	label := fmt.Sprintf("Close %s and discard its %d recorded steps? (y/N):", m.dataTable.FileName, steps)
+	m.openPrompt("CLOSE DATASET", label, "", func(m AppModel, value string) AppModel {
+		if answer := strings.ToLower(strings.TrimSpace(value)); answer != "y" && answer != "yes" {
+			m.tableMessage = ""
+			return m
+		}
+		m.closeDataset()
+		return m
+	})
+}
+
+// closeDataset drops the active dataset and shows its neighbour, or the menu when none is left
+func (m *AppModel) closeDataset() {
+	if m.dataTable == nil || m.activeDataset >= len(m.datasets) {
+		return
+	}
+	name := m.dataTable.FileName
+	i := m.activeDataset
+	m.datasets = append(m.datasets[:i:i], m.datasets[i+1:]...)
+
+	if len(m.datasets) == 0 {
+		m.activeDataset = 0
+		m.restoreWorkspace(newWorkspace())
+		m.currentView = menuView
+		m.statusText = fmt.Sprintf("✓ Closed %s", name)
+		return
+	}
+
+	m.activeDataset = min(i, len(m.datasets)-1)
+	m.restoreWorkspace(m.datasets[m.activeDataset])
+	m.statusText = fmt.Sprintf("✓ Closed %s, showing %s (%d of %d)",
+		name, m.dataTable.FileName, m.activeDataset+1, len(m.datasets))
+	m.tableMessage = m.statusText
+}
+
+// datasetNames returns the file names of the open datasets for the tab bar
+func (m AppModel) datasetNames() []string {
+	names := make([]string, len(m.datasets))
+	for i, ws := range m.datasets {
+		if i == m.activeDataset {
+			ws.dataTable = m.dataTable
+		}
+		if ws.dataTable != nil {
+			names[i] = ws.dataTable.FileName
+		}
+	}
+	return names
+}
@@ -0,0 +1,186 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/recipe"
+)
+
+// cursorCell returns the data row and column under the table cursor
+func (m AppModel) cursorCell() (models.CellRef, bool) {
+	if m.dataTable == nil || m.cursorRow >= m.visibleRowCount() {
+		return models.CellRef{}, false
+	}
+	cols := m.visibleColumns()
+	if m.cursorCol >= len(cols) {
+		return models.CellRef{}, false
+	}
+
+	row := m.cursorRow
+	if m.viewRows != nil {
+		row = m.viewRows[m.cursorRow]
+	}
+	return models.CellRef{Row: row, Col: cols[m.cursorCol]}, true
+}
+
+// tableCursor returns the cursor cell for rendering, nil when there is none
+func (m AppModel) tableCursor() *models.CellRef {
+	if cell, ok := m.cursorCell(); ok {
+		return &cell
+	}
+	return nil
+}
+
+// moveCursor moves the cursor by rows and columns and scrolls it into view
+func (m *AppModel) moveCursor(rows, cols int) {
+	m.cursorRow = max(0, min(m.cursorRow+rows, m.visibleRowCount()-1))
+	m.cursorCol = max(0, min(m.cursorCol+cols, len(m.visibleColumns())-1))
+
+	if m.cursorRow < m.scrollOffset {
+		m.scrollOffset = m.cursorRow
+	} else if m.cursorRow >= m.scrollOffset+m.pageSize {
+		m.scrollOffset = m.cursorRow - m.pageSize + 1
+	}
+
+	// Frozen columns are always shown; scroll the others until the cursor column fits
+	layout := m.tableLayout()
+	if layout.IsFrozen(m.cursorCol) {
+		return
+	}
+	if m.cursorCol < layout.Start {
+		m.columnOffset = m.cursorCol
+		return
+	}
+	for m.cursorCol >= layout.End && layout.Start < m.cursorCol {
+		m.columnOffset = layout.Start + 1
+		layout = m.tableLayout()
+	}
+}
+
+// clampCursor keeps the cursor on the visible page after the table or the scroll changed
+func (m *AppModel) clampCursor() {
+	lastRow := min(m.scrollOffset+m.pageSize, m.visibleRowCount()) - 1
+	m.cursorRow = max(0, max(m.scrollOffset, min(m.cursorRow, lastRow)))
+
+	m.columnOffset = max(0, min(m.columnOffset, len(m.visibleColumns())-1))
+	layout := m.tableLayout()
+	if !layout.IsFrozen(m.cursorCol) && layout.End > layout.Start {
+		m.cursorCol = max(layout.Start, min(m.cursorCol, layout.End-1))
+	}
+	m.cursorCol = max(0, min(m.cursorCol, layout.Total-1))
+}
+
+// openCellEditor starts editing the cell under the cursor
+func (m *AppModel) openCellEditor() {
+	cell, ok := m.cursorCell()
+	if !ok {
+		m.tableMessage = "⚠ No cell selected."
+		return
+	}
+
+	m.cellEditing = true
+	m.cellValue = ""
+	if row := m.dataTable.Rows[cell.Row]; cell.Col < len(row) {
+		m.cellValue = row[cell.Col]
+	}
+	m.tableMessage = fmt.Sprintf("Editing row %d, %s  (Enter: Save  |  Esc: Cancel)", cell.Row+1, m.dataTable.Headers[cell.Col])
+}
+
+// handleCellEditInput handles key presses while a cell is being edited
+func (m AppModel) handleCellEditInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc":
+		m.cellEditing = false
+		m.tableMessage = ""
+
+	case "enter":
+		m.cellEditing = false
+		m.commitCellEdit()
+
+	default:
+		m.cellValue, _ = editText(m.cellValue, msg)
+	}
+
+	return m, nil
+}
+
+// commitCellEdit writes the edited value and records it as a manual step
+func (m *AppModel) commitCellEdit() {
+	cell, ok := m.cursorCell()
+	if !ok {
+		return
+	}
+
+	edit := cleaner.CellEdit{Row: cell.Row, Column: m.dataTable.Headers[cell.Col], Value: m.cellValue}
+	if row := m.dataTable.Rows[cell.Row]; cell.Col < len(row) {
+		edit.Old = row[cell.Col]
+	}
+	if edit.Value == edit.Old {
+		m.tableMessage = ""
+		return
+	}
+
+	result, err := cleaner.EditCell(m.dataTable, edit)
+	if err != nil {
+		m.tableMessage = fmt.Sprintf("✗ %v", err)
+		return
+	}
+
+	m.dataTable = result
+	m.recordStep(recipe.OpEditCell, edit)
+	m.edited = append(m.edited, cell)
+	m.tableMessage = fmt.Sprintf("✓ Row %d, %s: %q → %q (U to undo)", cell.Row+1, edit.Column, edit.Old, edit.Value)
+}
+
+// insertRow adds an empty row below or above the cursor row and moves the cursor to it
+func (m *AppModel) insertRow(below bool) {
+	at := 0
+	if cell, ok := m.cursorCell(); ok {
+		at = cell.Row
+		if below {
+			at++
+		}
+	}
+
+	result, err := cleaner.InsertRow(m.dataTable, at, nil)
+	if err != nil {
+		m.tableMessage = fmt.Sprintf("✗ %v", err)
+		return
+	}
+
+	m.dataTable = result
+	m.clearCellMarks()
+	m.recordStep(recipe.OpInsertRow, recipe.InsertRowParams{Row: at})
+	m.tableMessage = fmt.Sprintf("✓ Row %d inserted (e to edit its cells, U to undo)", at+1)
+	if m.viewRows != nil {
+		m.tableMessage += ", it may be hidden by the filter or moved by the sort"
+		return
+	}
+	m.moveCursor(at-m.cursorRow, 0)
+}
+
+// deleteRow removes the row under the cursor
+func (m *AppModel) deleteRow() {
+	cell, ok := m.cursorCell()
+	if !ok {
+		m.tableMessage = "⚠ No row selected."
+		return
+	}
+
+	result, err := cleaner.DeleteRows(m.dataTable, []int{cell.Row})
+	if err != nil {
+		m.tableMessage = fmt.Sprintf("✗ %v", err)
+		return
+	}
+
+	m.dataTable = result
+	m.clearCellMarks()
+	m.recordStep(recipe.OpDeleteRows, recipe.RowsParams{Rows: []int{cell.Row}})
+	m.tableMessage = fmt.Sprintf("✓ Row %d deleted (U to undo)", cell.Row+1)
+}
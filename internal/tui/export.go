@@ -0,0 +1,77 @@
+package tui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/reshaper"
+)
+
+// openSplitExport asks how to split the table and where to write the parts
+func (m *AppModel) openSplitExport(column string) {
+	label := "Split by (columns comma separated, or #N for chunks of N rows):"
+	m.openPrompt("SPLIT EXPORT", label, column, func(m AppModel, spec string) AppModel {
+		opts, err := parsePartitionSpec(spec)
+		if err != nil {
+			m.columnMessage = fmt.Sprintf("✗ %v", err)
+			return m
+		}
+		parts, err := reshaper.PartitionTable(m.dataTable, opts)
+		if err != nil {
+			m.columnMessage = fmt.Sprintf("✗ %v", err)
+			return m
+		}
+
+		base, ext := splitBaseName(m.dataTable)
+		label := fmt.Sprintf("%d parts. Output directory, or a .xlsx file for one workbook:", len(parts))
+		m.openPrompt("SPLIT EXPORT", label, base+"_split", func(m AppModel, output string) AppModel {
+			output = strings.TrimSpace(output)
+			if strings.EqualFold(filepath.Ext(output), ".xlsx") {
+				if err := reshaper.SavePartitionsWorkbook(parts, output); err != nil {
+					m.columnMessage = fmt.Sprintf("✗ %v", err)
+					return m
+				}
+				m.columnMessage = fmt.Sprintf("✓ %d sheets written to %s", len(parts), output)
+				return m
+			}
+
+			paths, err := reshaper.SavePartitions(parts, output, base, ext)
+			if err != nil {
+				m.columnMessage = fmt.Sprintf("✗ %v", err)
+				return m
+			}
+			m.columnMessage = fmt.Sprintf("✓ %d files written to %s", len(paths), output)
+			return m
+		})
+		return m
+	})
+}
+
+// parsePartitionSpec turns "region, city" or "#1000" into partition options
+func parsePartitionSpec(spec string) (reshaper.PartitionOptions, error) {
+	spec = strings.TrimSpace(spec)
+	if rows, ok := strings.CutPrefix(spec, "#"); ok {
+		n, err := strconv.Atoi(strings.TrimSpace(rows))
+		if err != nil || n <= 0 {
+			return reshaper.PartitionOptions{}, fmt.Errorf("invalid row count %q", rows)
+		}
+		return reshaper.PartitionOptions{ChunkSize: n}, nil
+	}
+	return reshaper.PartitionOptions{Columns: splitHeaders(spec)}, nil
+}
+
+// splitBaseName returns the file name without extension and the extension used for parts
+func splitBaseName(dt *models.DataTable) (string, string) {
+	ext := strings.ToLower(filepath.Ext(dt.FileName))
+	if ext != ".xlsx" {
+		ext = ".csv"
+	}
+	base := strings.TrimSuffix(dt.FileName, filepath.Ext(dt.FileName))
+	if base == "" {
+		base = "data"
+	}
+	return base, ext
+}
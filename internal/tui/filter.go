@@ -0,0 +1,241 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/recipe"
+)
+
+// openViewFilter asks for a filter expression limiting the rows shown in the table view
+func (m *AppModel) openViewFilter() {
+	label := `Show rows where (e.g. city == "Ankara" and price > 100, in(city, "Ankara", "İzmir"), matches(code, "^TR"), isnull(email); empty to clear):`
+	m.openPrompt("FILTER ROWS", label, m.viewFilter, func(m AppModel, expression string) AppModel {
+		m.viewFilter = expression
+		m.viewFilterTable = nil
+		m.scrollOffset = 0
+		m.syncViewFilter()
+		return m
+	})
+}
+
+// applyViewFilter turns the current view filter into a permanent cleaning step
+func (m *AppModel) applyViewFilter() {
+	if m.viewFilter == "" {
+		m.statusText = "⚠ No view filter to apply. Press f to set one."
+		return
+	}
+
+	f := cleaner.Filter{Expression: m.viewFilter, Action: cleaner.FilterKeep}
+	result, removed, err := cleaner.FilterRows(m.dataTable, f)
+	if err != nil {
+		m.statusText = fmt.Sprintf("✗ %v", err)
+		return
+	}
+
+	m.dataTable = result
+	m.clearCellMarks()
+	m.recordStep(recipe.OpFilterRows, f)
+	m.viewFilter = ""
+	m.viewFilterTable = nil
+	m.scrollOffset = 0
+	m.statusText = fmt.Sprintf("✓ Filter applied, %d rows removed", removed)
+}
+
+// syncViewFilter recomputes the filtered and sorted rows when the table has changed
+func (m *AppModel) syncViewFilter() {
+	if (m.viewFilter == "" && len(m.viewSort) == 0) || m.dataTable == nil {
+		m.viewRows = nil
+		m.viewFilterTable = nil
+		return
+	}
+	if m.viewFilterTable == m.dataTable {
+		return
+	}
+
+	var rows []int
+	if m.viewFilter != "" {
+		var err error
+		rows, err = cleaner.MatchingRows(m.dataTable, cleaner.Filter{Expression: m.viewFilter})
+		if err != nil {
+			m.statusText = fmt.Sprintf("✗ Filter cleared: %v", err)
+			m.viewFilter = ""
+			m.viewFilterTable = nil
+			m.syncViewFilter()
+			return
+		}
+	}
+
+	if len(m.viewSort) > 0 {
+		sorted, err := cleaner.SortOrder(m.dataTable, m.viewSort, rows)
+		if err != nil {
+			m.statusText = fmt.Sprintf("✗ Sort cleared: %v", err)
+			m.viewSort = nil
+			m.viewFilterTable = nil
+			m.syncViewFilter()
+			return
+		}
+		rows = sorted
+	}
+
+	m.viewRows = rows
+	m.viewFilterTable = m.dataTable
+	if m.scrollOffset > max(0, len(rows)-1) {
+		m.scrollOffset = 0
+	}
+}
+
+// visibleRowCount returns the number of rows shown in the table view
+func (m AppModel) visibleRowCount() int {
+	if m.viewRows != nil {
+		return len(m.viewRows)
+	}
+	if m.dataTable == nil {
+		return 0
+	}
+	return m.dataTable.RowCount()
+}
+
+// openViewSort asks for the sort keys of the table view
+func (m *AppModel) openViewSort() {
+	current := make([]string, len(m.viewSort))
+	for i, key := range m.viewSort {
+		current[i] = key.String()
+	}
+	if cols := m.visibleColumns(); len(current) == 0 && m.columnOffset < len(cols) {
+		current = append(current, m.dataTable.Headers[cols[m.columnOffset]])
+	}
+
+	label := "Sort by (column [desc] [numeric|date|natural|text|tr], ...; empty to clear):"
+	m.openPrompt("SORT ROWS", label, strings.Join(current, ", "), func(m AppModel, spec string) AppModel {
+		keys, err := parseSortSpec(m.dataTable, spec)
+		if err != nil {
+			m.statusText = fmt.Sprintf("✗ %v", err)
+			return m
+		}
+		m.setViewSort(keys)
+		return m
+	})
+}
+
+// toggleSortColumn cycles a column through ascending, descending and unsorted
+// Other sorted columns are kept, so pressing it on several columns builds a multi-key sort
+func (m *AppModel) toggleSortColumn(colIdx int) {
+	header := m.dataTable.Headers[colIdx]
+	keys := append([]cleaner.SortKey(nil), m.viewSort...)
+
+	for i, key := range keys {
+		if key.Column != header {
+			continue
+		}
+		if key.Descending {
+			keys = append(keys[:i], keys[i+1:]...)
+		} else {
+			keys[i].Descending = true
+		}
+		m.setViewSort(keys)
+		return
+	}
+
+	m.setViewSort(append(keys, cleaner.SortKey{Column: header}))
+}
+
+// setViewSort replaces the view sort and recomputes the visible rows
+func (m *AppModel) setViewSort(keys []cleaner.SortKey) {
+	m.viewSort = keys
+	m.viewFilterTable = nil
+	m.scrollOffset = 0
+	m.syncViewFilter()
+}
+
+// applyViewSort turns the current view sort into a permanent cleaning step
+func (m *AppModel) applyViewSort() {
+	if len(m.viewSort) == 0 {
+		m.statusText = "⚠ No view sort to apply. Press s to set one."
+		return
+	}
+
+	result, err := cleaner.SortRows(m.dataTable, m.viewSort)
+	if err != nil {
+		m.statusText = fmt.Sprintf("✗ %v", err)
+		return
+	}
+
+	m.dataTable = result
+	m.clearCellMarks()
+	m.recordStep(recipe.OpSortRows, m.viewSort)
+	m.statusText = fmt.Sprintf("✓ Rows sorted by %s", sortLabel(m.viewSort))
+	m.viewSort = nil
+	m.viewFilterTable = nil
+	m.scrollOffset = 0
+}
+
+// sortMarkers returns the header markers of the sorted columns: ▲ or ▼ and the key priority
+func (m AppModel) sortMarkers() map[int]string {
+	if len(m.viewSort) == 0 || m.dataTable == nil {
+		return nil
+	}
+
+	markers := make(map[int]string, len(m.viewSort))
+	for i, key := range m.viewSort {
+		arrow := "▲"
+		if key.Descending {
+			arrow = "▼"
+		}
+		if colIdx := m.dataTable.ColumnIndex(key.Column); colIdx != -1 {
+			markers[colIdx] = fmt.Sprintf("%s%d", arrow, i+1)
+		}
+	}
+	return markers
+}
+
+// sortLabel formats sort keys for the info line
+func sortLabel(keys []cleaner.SortKey) string {
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = key.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// parseSortSpec parses "city tr, price desc numeric" into sort keys
+// Trailing words naming a direction or collation are options, the rest is the column
+func parseSortSpec(dt *models.DataTable, spec string) ([]cleaner.SortKey, error) {
+	var keys []cleaner.SortKey
+	for _, part := range splitHeaders(spec) {
+		words := strings.Fields(part)
+		var key cleaner.SortKey
+		for len(words) > 1 && sortOption(&key, words[len(words)-1]) {
+			words = words[:len(words)-1]
+		}
+
+		key.Column = strings.Join(words, " ")
+		if dt.ColumnIndex(key.Column) == -1 {
+			return nil, fmt.Errorf("column %q not found", key.Column)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// sortOption applies a direction or collation word to a sort key
+// Returns false when the word is not an option
+func sortOption(key *cleaner.SortKey, word string) bool {
+	word = strings.ToLower(word)
+	switch {
+	case word == "asc":
+		key.Descending = false
+	case word == "desc":
+		key.Descending = true
+	case word == "tr":
+		key.Collation = cleaner.CollateTurkish
+	case slices.Contains(cleaner.Collations, cleaner.Collation(word)):
+		key.Collation = cleaner.Collation(word)
+	default:
+		return false
+	}
+	return true
+}
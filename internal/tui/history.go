@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// maxHistory limits the number of undo snapshots kept in memory
+// Row edits share unchanged rows between snapshots, so only whole-table operations cost a full copy
+const maxHistory = 30
+
+// snapshot is a table state restored by undo
+type snapshot struct {
+	table *models.DataTable
+	steps int    // recipe length when the snapshot was taken
+	label string // operation that replaced the table
+}
+
+// trackHistory remembers the previous table when a key press replaced it
+// Operations never modify a table in place, so a new pointer means a new state
+func (m *AppModel) trackHistory(prev AppModel) {
+	if prev.dataTable == nil || m.dataTable == nil || prev.dataTable == m.dataTable {
+		return
+	}
+	if m.activeDataset != prev.activeDataset || len(m.datasets) != len(prev.datasets) {
+		return // another dataset is shown
+	}
+	if len(m.history) < len(prev.history) {
+		return // undo itself
+	}
+
+	label := "edit"
+	if steps := len(prev.recipe.Steps); len(m.recipe.Steps) > steps {
+		label = m.recipe.Steps[steps].Op
+	}
+
+	m.history = append(m.history, snapshot{table: prev.dataTable, steps: len(prev.recipe.Steps), label: label})
+	if len(m.history) > maxHistory {
+		m.history = append([]snapshot(nil), m.history[len(m.history)-maxHistory:]...)
+	}
+}
+
+// undo restores the table before the last operation and drops its recipe step
+func (m *AppModel) undo() string {
+	if len(m.history) == 0 {
+		return "⚠ Nothing to undo."
+	}
+
+	last := m.history[len(m.history)-1]
+	m.history = m.history[:len(m.history)-1]
+	m.dataTable = last.table
+	if last.steps < len(m.recipe.Steps) {
+		m.recipe.Steps = m.recipe.Steps[:last.steps]
+	}
+
+	m.clearCellMarks()
+	m.columnMarks = nil
+	m.viewFilterTable = nil
+	m.selectedColumn = min(m.selectedColumn, max(0, m.dataTable.ColumnCount()-1))
+	m.columnOffset = min(m.columnOffset, max(0, m.dataTable.ColumnCount()-1))
+	return fmt.Sprintf("↶ Undid %s (%d more to undo)", last.label, len(m.history))
+}
@@ -0,0 +1,55 @@
+package tui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// editText applies a key press to a single-line text value
+// Returns the new value and whether the key was consumed
+func editText(value string, msg tea.KeyMsg) (string, bool) {
+	switch msg.Type {
+	case tea.KeyRunes:
+		return value + string(msg.Runes), true
+	case tea.KeySpace:
+		return value + " ", true
+	case tea.KeyBackspace:
+		runes := []rune(value)
+		if len(runes) > 0 {
+			runes = runes[:len(runes)-1]
+		}
+		return string(runes), true
+	}
+	return value, false
+}
+
+// openPrompt shows a text input dialog; submit is called with the entered value
+func (m *AppModel) openPrompt(title, label, value string, submit func(AppModel, string) AppModel) {
+	m.promptActive = true
+	m.promptTitle = title
+	m.promptLabel = label
+	m.promptValue = value
+	m.promptSubmit = submit
+}
+
+// handlePromptInput handles key presses while a prompt is open
+func (m AppModel) handlePromptInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc":
+		m.promptActive = false
+
+	case "enter":
+		m.promptActive = false
+		if m.promptSubmit != nil {
+			m = m.promptSubmit(m, m.promptValue)
+		}
+		cmd := m.promptCmd
+		m.promptCmd = nil
+		return m, cmd
+
+	default:
+		m.promptValue, _ = editText(m.promptValue, msg)
+	}
+
+	return m, nil
+}
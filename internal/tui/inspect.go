@@ -0,0 +1,134 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/veliulugut/snapclean/internal/profiler"
+	"github.com/veliulugut/snapclean/internal/tui/components"
+)
+
+// openInspector shows the row under the cursor, starting at the cursor column
+func (m *AppModel) openInspector() {
+	cell, ok := m.cursorCell()
+	if !ok {
+		m.tableMessage = "⚠ No row to inspect."
+		return
+	}
+	m.inspecting = true
+	m.inspectField = cell.Col
+	m.updateColumnTypes()
+}
+
+// handleInspectorNavigation handles key presses while the row inspector is open
+func (m AppModel) handleInspectorNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+
+	case "i", "esc", "b":
+		m.inspecting = false
+
+	case "up", "k":
+		if m.inspectField > 0 {
+			m.inspectField--
+		}
+
+	case "down", "j":
+		if m.inspectField < m.dataTable.ColumnCount()-1 {
+			m.inspectField++
+		}
+
+	case "home":
+		m.inspectField = 0
+
+	case "end":
+		m.inspectField = m.dataTable.ColumnCount() - 1
+
+	case "left", "h":
+		m.moveCursor(-1, 0)
+
+	case "right", "l":
+		m.moveCursor(1, 0)
+
+	case "e", "enter":
+		// Edit the field in the table, where the cell editor lives
+		pos := m.columnPosition(m.inspectField)
+		if pos < 0 {
+			m.tableMessage = "⚠ The field is hidden in the table. Show it from the column menu to edit it."
+			m.inspecting = false
+			return m, nil
+		}
+		m.inspecting = false
+		m.moveCursor(0, pos-m.cursorCol)
+		m.openCellEditor()
+	}
+
+	return m, nil
+}
+
+// inspectorViewModel collects the state rendered by the row inspector
+func (m AppModel) inspectorViewModel() components.InspectorViewModel {
+	vm := components.InspectorViewModel{
+		Data:      m.dataTable,
+		Row:       -1,
+		Position:  m.cursorRow,
+		TotalRows: m.visibleRowCount(),
+		Selected:  m.inspectField,
+		Checked:   m.issues != nil,
+		Hidden:    m.hiddenColumns,
+		Width:     m.width,
+		Height:    m.height,
+	}
+
+	if m.columnTypesFor == m.dataTable {
+		vm.Types = m.columnTypes
+	}
+
+	cell, ok := m.cursorCell()
+	if !ok {
+		return vm
+	}
+	vm.Row = cell.Row
+
+	row := m.dataTable.Rows[cell.Row]
+	vm.ValueTypes = make([]string, m.dataTable.ColumnCount())
+	for colIdx := range vm.ValueTypes {
+		value := ""
+		if colIdx < len(row) {
+			value = row[colIdx]
+		}
+		if m.dataTable.IsMissing(colIdx, value) {
+			vm.ValueTypes[colIdx] = profiler.TypeEmpty
+		} else {
+			vm.ValueTypes[colIdx] = profiler.InferType([]string{value})
+		}
+	}
+
+	for _, issue := range m.issues {
+		if issue.Row == cell.Row {
+			vm.Issues = append(vm.Issues, issue)
+		}
+	}
+	return vm
+}
+
+// updateColumnTypes infers the type of every column, once per table
+func (m *AppModel) updateColumnTypes() {
+	if m.columnTypesFor == m.dataTable {
+		return
+	}
+
+	types := make([]string, m.dataTable.ColumnCount())
+	for colIdx := range types {
+		cells, _ := m.dataTable.GetColumn(colIdx)
+		values := make([]string, 0, len(cells))
+		for _, cell := range cells {
+			if !m.dataTable.IsMissing(colIdx, cell) {
+				values = append(values, cell)
+			}
+		}
+		types[colIdx] = profiler.InferType(values)
+	}
+
+	m.columnTypes = types
+	m.columnTypesFor = m.dataTable
+}
@@ -0,0 +1,53 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/veliulugut/snapclean/internal/tui/components"
+)
+
+// maxColumnWidth limits column widths before the terminal size is known
+const maxColumnWidth = 200
+
+// toggleFrozenColumns freezes the visible columns up to the cursor, or unfreezes them
+func (m *AppModel) toggleFrozenColumns() {
+	if m.frozenColumns > 0 {
+		m.columnOffset = m.frozenStart
+		m.frozenColumns, m.frozenStart = 0, 0
+		m.tableMessage = "✓ Columns unfrozen"
+		return
+	}
+
+	// Columns scrolled off to the left stay scrollable
+	layout := m.tableLayout()
+	if m.cursorCol < layout.Start || m.cursorCol >= layout.End {
+		return
+	}
+	m.frozenStart = layout.Start
+	m.frozenColumns = m.cursorCol - layout.Start + 1
+	m.columnOffset = m.cursorCol + 1
+	m.tableMessage = fmt.Sprintf("✓ %d columns frozen (p to unfreeze)", m.frozenColumns)
+}
+
+// resizeColumn widens (delta > 0) or narrows the cursor column
+func (m *AppModel) resizeColumn(delta int) {
+	cell, ok := m.cursorCell()
+	if !ok {
+		return
+	}
+
+	vm := components.TableViewModel{Data: m.dataTable, ColumnWidths: m.columnWidths, SortMarkers: m.sortMarkers()}
+	limit := maxColumnWidth
+	if m.width > 0 {
+		limit = max(components.TableMinColumnWidth, m.width-12)
+	}
+	width := max(components.TableMinColumnWidth, min(components.ColumnWidth(vm, cell.Col)+delta, limit))
+
+	if m.columnWidths == nil {
+		m.columnWidths = make(map[string]int)
+	}
+	header := m.dataTable.Headers[cell.Col]
+	m.columnWidths[header] = width
+	m.moveCursor(0, 0)
+	m.tableMessage = fmt.Sprintf("Width of %s: %d", header, width)
+}
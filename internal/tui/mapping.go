@@ -0,0 +1,183 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/recipe"
+)
+
+// openMapping shows the distinct values of a column for mapping
+func (m *AppModel) openMapping(column string) {
+	m.currentView = mappingView
+	m.mappingColumn = column
+	m.mappingTargets = make(map[string]string)
+	m.mappingMarked = make(map[string]bool)
+	m.mappingCursor = 0
+	m.mappingMessage = ""
+	m.refreshMappingValues()
+}
+
+// refreshMappingValues reloads the distinct values of the mapped column
+func (m *AppModel) refreshMappingValues() {
+	values, err := cleaner.DistinctValues(m.dataTable, m.mappingColumn)
+	if err != nil {
+		m.mappingMessage = fmt.Sprintf("✗ %v", err)
+	}
+	m.mappingValues = values
+	m.mappingID++
+	m.mappingCursor = min(m.mappingCursor, max(0, len(values)-1))
+}
+
+// clustersMsg carries the cluster suggestions computed in the background
+type clustersMsg struct {
+	id       int
+	clusters []cleaner.Cluster
+}
+
+// suggestClusters groups similar values off the update loop
+func suggestClusters(id int, values []cleaner.ValueCount) tea.Cmd {
+	return func() tea.Msg {
+		return clustersMsg{id: id, clusters: cleaner.SuggestClusters(values)}
+	}
+}
+
+// applyClusters maps the suggested values unless the values changed meanwhile
+func (m AppModel) applyClusters(msg clustersMsg) AppModel {
+	if m.currentView != mappingView || msg.id != m.mappingID {
+		return m
+	}
+	suggested := 0
+	for _, c := range msg.clusters {
+		for _, v := range c.Values {
+			if _, mapped := m.mappingTargets[v.Value]; !mapped && v.Value != c.Canonical {
+				m.mappingTargets[v.Value] = c.Canonical
+				suggested++
+			}
+		}
+	}
+	m.mappingMessage = fmt.Sprintf("✓ %d clusters found, %d values mapped. Review and press Enter to apply.", len(msg.clusters), suggested)
+	return m
+}
+
+// handleMappingNavigation handles key presses in the value mapping view
+func (m AppModel) handleMappingNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "b", "esc":
+		m.currentView = tableView
+		return m, nil
+
+	case "q", "ctrl+c":
+		return m, tea.Quit
+
+	case "up", "k":
+		if m.mappingCursor > 0 {
+			m.mappingCursor--
+		}
+
+	case "down", "j":
+		if m.mappingCursor < len(m.mappingValues)-1 {
+			m.mappingCursor++
+		}
+
+	case " ":
+		if len(m.mappingValues) > 0 {
+			value := m.mappingValues[m.mappingCursor].Value
+			m.mappingMarked[value] = !m.mappingMarked[value]
+		}
+
+	case "m":
+		selected := m.selectedMappingValues()
+		if len(selected) == 0 {
+			return m, nil
+		}
+		label := fmt.Sprintf("Canonical value for %d values:", len(selected))
+		m.openPrompt("MAP VALUES", label, selected[0], func(m AppModel, canonical string) AppModel {
+			for _, value := range selected {
+				m.mappingTargets[value] = canonical
+			}
+			m.mappingMarked = make(map[string]bool)
+			m.mappingMessage = ""
+			return m
+		})
+
+	case "x":
+		for _, value := range m.selectedMappingValues() {
+			delete(m.mappingTargets, value)
+		}
+		m.mappingMarked = make(map[string]bool)
+
+	case "g":
+		m.mappingMessage = "⏳ Looking for clusters..."
+		if len(m.mappingValues) > cleaner.MaxSpellingCandidates {
+			m.mappingMessage = fmt.Sprintf("⏳ Looking for clusters (spelling checked for the %d most frequent values)...", cleaner.MaxSpellingCandidates)
+		}
+		return m, suggestClusters(m.mappingID, m.mappingValues)
+
+	case "w":
+		if len(m.mappingTargets) == 0 {
+			m.mappingMessage = "⚠ Nothing to save."
+			return m, nil
+		}
+		mapping := m.pendingMapping()
+		m.openPrompt("SAVE MAPPING", "Recipe file path:", m.mappingColumn+"_mapping.json", func(m AppModel, path string) AppModel {
+			var r recipe.Recipe
+			if err := r.Add(recipe.OpMapValues, mapping); err != nil {
+				m.mappingMessage = fmt.Sprintf("✗ %v", err)
+				return m
+			}
+			if err := r.Save(path); err != nil {
+				m.mappingMessage = fmt.Sprintf("✗ %v", err)
+				return m
+			}
+			m.mappingMessage = fmt.Sprintf("✓ Saved %d mappings to %s", len(mapping.Values), path)
+			return m
+		})
+
+	case "enter":
+		if len(m.mappingTargets) == 0 {
+			m.mappingMessage = "⚠ No values mapped. Press m to map or g for suggestions."
+			return m, nil
+		}
+		mapping := m.pendingMapping()
+		result, changed, err := cleaner.MapValues(m.dataTable, mapping)
+		if err != nil {
+			m.mappingMessage = fmt.Sprintf("✗ %v", err)
+			return m, nil
+		}
+		m.dataTable = result
+		m.recordStep(recipe.OpMapValues, mapping)
+		m.mappingTargets = make(map[string]string)
+		m.refreshMappingValues()
+		m.mappingMessage = fmt.Sprintf("✓ Mapped %d values, %d cells changed", len(mapping.Values), changed)
+		m.statusText = m.mappingMessage
+	}
+
+	return m, nil
+}
+
+// selectedMappingValues returns the marked values, or the value under the cursor
+func (m AppModel) selectedMappingValues() []string {
+	var selected []string
+	for _, v := range m.mappingValues {
+		if m.mappingMarked[v.Value] {
+			selected = append(selected, v.Value)
+		}
+	}
+	if len(selected) == 0 && len(m.mappingValues) > 0 {
+		selected = append(selected, m.mappingValues[m.mappingCursor].Value)
+	}
+	return selected
+}
+
+// pendingMapping returns the mapping table built so far
+func (m AppModel) pendingMapping() cleaner.ValueMapping {
+	values := make(map[string]string, len(m.mappingTargets))
+	for from, to := range m.mappingTargets {
+		if from != to {
+			values[from] = to
+		}
+	}
+	return cleaner.ValueMapping{Column: m.mappingColumn, Values: values}
+}
@@ -1,10 +1,14 @@
 package tui
 
 import (
+	"context"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/veliulugut/snapclean/internal/cleaner"
 	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/profiler"
+	"github.com/veliulugut/snapclean/internal/recipe"
 )
 
 type viewMode int
@@ -16,6 +20,12 @@ const (
 	cleaningView
 	exportView
 	helpView
+	fillView
+	replaceView
+	recipeView
+	mappingView
+	checkView
+	profileView
 )
 
 type AppModel struct {
@@ -26,22 +36,122 @@ type AppModel struct {
 	// Data
 	dataTable *models.DataTable
 
+	// Open datasets (tabs); the active one's state lives in the fields of this model
+	datasets      []workspace
+	activeDataset int
+
 	// Table view state
 	scrollOffset int // vertical scroll (rows)
 	pageSize     int // number of rows per page
 	columnOffset int // horizontal scroll (columns)
 
+	// Table layout set by the user
+	frozenColumns int            // visible columns kept in place while scrolling
+	frozenStart   int            // position of the first frozen column among the visible columns
+	columnWidths  map[string]int // column widths by header (unset columns fit their values)
+
+	// Cell cursor and inline editor (table view)
+	cursorRow   int              // cursor position among the visible rows
+	cursorCol   int              // cursor position among the visible columns
+	cellEditing bool             // true while typing a new value for the cursor cell
+	cellValue   string           // value being typed
+	edited      []models.CellRef // cells changed by hand since the last structural change
+
+	// Row inspector state (table view)
+	inspecting     bool              // true while the row inspector is open
+	inspectField   int               // column index of the selected field
+	columnTypes    []string          // inferred column types shown by the inspector
+	columnTypesFor *models.DataTable // table columnTypes were inferred for
+
+	// Search state (table view); searches run in the background
+	searchActive  bool               // true while typing the query
+	searchQuery   string             // query, /regex/ for a regular expression
+	searchColumn  int                // column index searched, -1 for every visible column
+	searchMatches []searchMatch      // matches of the last search in view order
+	searchIndex   int                // match selected with n/N, -1 for none
+	searchErr     error              // error of the last search (e.g. invalid regex)
+	searchRunning bool               // true while a search runs
+	searchID      int                // id of the latest search, older results are dropped
+	searchFor     string             // searchKey the latest search was started for
+	searchCancel  context.CancelFunc // cancels the running search
+
+	// Temporary view filter and sort (table view only, data is untouched)
+	viewFilter      string            // filter expression, empty when off
+	viewSort        []cleaner.SortKey // sort keys, empty when off
+	viewRows        []int             // indices of rows matching the filter, in sort order
+	viewFilterTable *models.DataTable // table viewRows were computed for
+
 	// Column management state
-	columnMenuMode bool   // true when column menu is active
-	selectedColumn int    // currently selected column in menu
-	swapSourceCol  int    // first column selected for swap (-1 if none)
-	columnMessage  string // feedback message for column operations
+	columnMenuMode bool            // true when column menu is active
+	selectedColumn int             // currently selected column in menu
+	swapSourceCol  int             // first column selected for swap (-1 if none)
+	columnMessage  string          // feedback message for column operations
+	columnMarks    map[int]bool    // columns marked with space for multi-column operations
+	hiddenColumns  map[string]bool // headers hidden from the table view (data is kept)
+
+	// Undo history (previous tables, newest last)
+	history []snapshot
+
+	// Fill state
+	fillColumn   int    // column being filled
+	fillStrategy int    // index into cleaner.FillStrategies
+	fillGroupBy  int    // group-by column (-1 if none)
+	fillValue    string // constant fill value
+	fillEditing  bool   // true while typing the constant value
+	fillMessage  string
+	imputed      []models.CellRef // cells filled by the last fill step
+
+	// Cells flagged by the last conversion step (e.g. unparseable dates)
+	flagged []models.CellRef
+
+	// Find/replace state
+	replaceOptions cleaner.ReplaceOptions
+	replaceColumns string // comma separated headers as typed
+	replaceFocus   int
+	replacePreview cleaner.ReplacePreview
+	replaceError   string
+	replaceMessage string
+
+	// Value mapping state
+	mappingColumn  string
+	mappingValues  []cleaner.ValueCount
+	mappingTargets map[string]string // pending value -> canonical value
+	mappingMarked  map[string]bool
+	mappingCursor  int
+	mappingMessage string
+	mappingID      int // bumped when the values are reloaded, to drop stale suggestions
+
+	// Column profile state
+	profileColumn int
+	profile       profiler.ColumnProfile
+
+	// QA check state
+	checkResult     cleaner.ValidationResult
+	checkViolations []cleaner.Violation
+	checkMessage    string
+	rules           cleaner.RuleSet // validation rules checked by CHECK
+	issues          []cleaner.Issue // all issues of the last check, sorted by position
+	issueIndex      int             // issue selected with n/N, -1 for none
+	issuePage       int             // page of the issue list in the check view
+	tableMessage    string          // message shown above the table
+
+	// Recipe state (steps applied during this session)
+	recipe        recipe.Recipe
+	recipeMessage string
 
 	// Cleaning state
 	cleaningOptions  models.CleanOptions
 	cleaningSelected int
 	cleaningMessage  string
 
+	// Prompt state (single-line text input shown over the current view)
+	promptActive bool
+	promptTitle  string
+	promptLabel  string
+	promptValue  string
+	promptSubmit func(AppModel, string) AppModel
+	promptCmd    tea.Cmd // Command a submit asks to run, such as loading a file
+
 	// UI State
 	loadedFile string
 	statusText string
@@ -63,7 +173,11 @@ func InitialModel() AppModel {
 		columnMenuMode:   false,
 		selectedColumn:   0,
 		swapSourceCol:    -1,
+		searchIndex:      -1,
+		searchColumn:     -1,
 		columnMessage:    "",
+		fillGroupBy:      -1,
+		issueIndex:       -1,
 		cleaningSelected: 0,
 		cleaningMessage:  "",
 		splashTick:       0,
@@ -74,6 +188,9 @@ func InitialModel() AppModel {
 			"[ CLEAN ]  Clean Data",
 			"[ PIVOT ]  Summarize / Pivot",
 			"[ CHECK ]  Run QA Checks",
+			"[ RECIPE ] Save / Apply Recipe",
+			"[ JOIN ]   Join Another File",
+			"[ APPEND ] Append Files",
 			"[ SAVE ]   Export Data",
 			"[ HELP ]   Show Help",
 			"[ EXIT ]   Quit Application",
@@ -86,6 +203,7 @@ func InitialModel() AppModel {
 			RemoveEmptyRows:    true,
 			RemoveEmptyColumns: true,
 			RemoveDuplicates:   true,
+			StandardizeNulls:   false,
 		},
 	}
 }
@@ -0,0 +1,38 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/veliulugut/snapclean/internal/profiler"
+)
+
+// openProfile shows the profile of a column
+func (m *AppModel) openProfile(colIdx int) {
+	m.currentView = profileView
+	m.profileColumn = colIdx
+	m.profile = profiler.ProfileColumn(m.dataTable, colIdx)
+}
+
+// handleProfileNavigation handles key presses in the column profile view
+func (m AppModel) handleProfileNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "b", "esc":
+		m.currentView = tableView
+		m.selectedColumn = m.profileColumn
+		return m, nil
+
+	case "q", "ctrl+c":
+		return m, tea.Quit
+
+	case "left", "h":
+		if m.profileColumn > 0 {
+			m.openProfile(m.profileColumn - 1)
+		}
+
+	case "right", "l":
+		if m.profileColumn < m.dataTable.ColumnCount()-1 {
+			m.openProfile(m.profileColumn + 1)
+		}
+	}
+
+	return m, nil
+}
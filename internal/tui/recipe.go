@@ -0,0 +1,72 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/veliulugut/snapclean/internal/recipe"
+)
+
+// recordStep appends an applied operation to the session recipe
+func (m *AppModel) recordStep(op string, params any) {
+	if err := m.recipe.Add(op, params); err != nil {
+		m.statusText = fmt.Sprintf("⚠ Step not recorded: %v", err)
+	}
+}
+
+// handleRecipeNavigation handles key presses in the recipe view
+func (m AppModel) handleRecipeNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "b", "esc":
+		m.currentView = menuView
+		m.recipeMessage = ""
+		return m, nil
+
+	case "q", "ctrl+c":
+		return m, tea.Quit
+
+	case "s":
+		if len(m.recipe.Steps) == 0 {
+			m.recipeMessage = "⚠ Nothing to save."
+			return m, nil
+		}
+		m.openPrompt("SAVE RECIPE", "File path:", "recipe.json", func(m AppModel, path string) AppModel {
+			if err := m.recipe.Save(path); err != nil {
+				m.recipeMessage = fmt.Sprintf("✗ %v", err)
+				return m
+			}
+			m.recipeMessage = fmt.Sprintf("✓ Saved %d steps to %s", len(m.recipe.Steps), path)
+			return m
+		})
+
+	case "a":
+		if m.dataTable == nil {
+			m.recipeMessage = "⚠ No data loaded."
+			return m, nil
+		}
+		m.openPrompt("APPLY RECIPE", "Recipe file path:", "recipe.json", func(m AppModel, path string) AppModel {
+			r, err := recipe.Load(path)
+			if err != nil {
+				m.recipeMessage = fmt.Sprintf("✗ %v", err)
+				return m
+			}
+			result, err := r.Apply(m.dataTable)
+			if err != nil {
+				m.recipeMessage = fmt.Sprintf("✗ %v", err)
+				return m
+			}
+			m.dataTable = result
+			m.clearCellMarks()
+			m.recipe.Steps = append(m.recipe.Steps, r.Steps...)
+			m.recipeMessage = fmt.Sprintf("✓ Applied %d steps from %s", len(r.Steps), path)
+			m.statusText = m.recipeMessage
+			return m
+		})
+
+	case "x":
+		m.recipe = recipe.Recipe{}
+		m.recipeMessage = "✓ Recipe cleared"
+	}
+
+	return m, nil
+}
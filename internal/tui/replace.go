@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/recipe"
+	"github.com/veliulugut/snapclean/internal/tui/components"
+)
+
+// replacePreviewSamples is the number of before/after samples shown in the preview
+const replacePreviewSamples = 5
+
+// openReplace shows the find/replace form scoped to the given columns
+func (m *AppModel) openReplace(columns string) {
+	m.currentView = replaceView
+	m.replaceOptions = cleaner.ReplaceOptions{}
+	m.replaceColumns = columns
+	m.replaceFocus = components.ReplaceFieldFind
+	m.replaceMessage = ""
+	m.refreshReplacePreview()
+}
+
+// handleReplaceNavigation handles input in the find/replace view
+func (m AppModel) handleReplaceNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.currentView = tableView
+		return m, nil
+
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "tab", "down":
+		m.replaceFocus = (m.replaceFocus + 1) % components.ReplaceFieldCount
+		return m, nil
+
+	case "shift+tab", "up":
+		m.replaceFocus = (m.replaceFocus + components.ReplaceFieldCount - 1) % components.ReplaceFieldCount
+		return m, nil
+
+	case "enter":
+		m.replaceOptions.Columns = splitHeaders(m.replaceColumns)
+		result, changed, err := cleaner.FindReplace(m.dataTable, m.replaceOptions)
+		if err != nil {
+			m.replaceError = err.Error()
+			return m, nil
+		}
+		m.dataTable = result
+		m.recordStep(recipe.OpReplace, m.replaceOptions)
+		m.replaceMessage = fmt.Sprintf("✓ Replaced values in %d cells", changed)
+		m.statusText = m.replaceMessage
+		m.refreshReplacePreview()
+		return m, nil
+	}
+
+	switch m.replaceFocus {
+	case components.ReplaceFieldFind:
+		m.replaceOptions.Find, _ = editText(m.replaceOptions.Find, msg)
+	case components.ReplaceFieldReplace:
+		m.replaceOptions.Replace, _ = editText(m.replaceOptions.Replace, msg)
+	case components.ReplaceFieldColumns:
+		m.replaceColumns, _ = editText(m.replaceColumns, msg)
+	default:
+		if msg.String() == " " {
+			switch m.replaceFocus {
+			case components.ReplaceFieldRegex:
+				m.replaceOptions.Regex = !m.replaceOptions.Regex
+			case components.ReplaceFieldIgnoreCase:
+				m.replaceOptions.IgnoreCase = !m.replaceOptions.IgnoreCase
+			case components.ReplaceFieldWholeCell:
+				m.replaceOptions.WholeCell = !m.replaceOptions.WholeCell
+			}
+		}
+	}
+
+	m.replaceMessage = ""
+	m.refreshReplacePreview()
+	return m, nil
+}
+
+// refreshReplacePreview recomputes the match preview for the current form values
+func (m *AppModel) refreshReplacePreview() {
+	m.replacePreview = cleaner.ReplacePreview{}
+	m.replaceError = ""
+	if m.replaceOptions.Find == "" {
+		return
+	}
+
+	m.replaceOptions.Columns = splitHeaders(m.replaceColumns)
+	preview, err := cleaner.PreviewReplace(m.dataTable, m.replaceOptions, replacePreviewSamples)
+	if err != nil {
+		m.replaceError = err.Error()
+		return
+	}
+	m.replacePreview = preview
+}
+
+// splitHeaders parses a comma separated list of headers
+func splitHeaders(value string) []string {
+	var headers []string
+	for _, header := range strings.Split(value, ",") {
+		if header = strings.TrimSpace(header); header != "" {
+			headers = append(headers, header)
+		}
+	}
+	return headers
+}
@@ -0,0 +1,279 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+)
+
+// searchMatch is a matching cell and its position in the table view
+type searchMatch struct {
+	row, col  int // data row and column index
+	pos, cpos int // position among the visible rows and columns
+}
+
+// searchResultMsg carries the matches of a background search
+type searchResultMsg struct {
+	id      int
+	matches []searchMatch
+	err     error
+}
+
+// openSearch starts typing a search query in the table view
+func (m *AppModel) openSearch() {
+	m.searchActive = true
+	m.searchQuery = ""
+	m.searchColumn = -1
+}
+
+// handleSearchInput handles key presses while the search query is typed
+// The search itself runs in the background, see refreshSearch
+func (m AppModel) handleSearchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc":
+		m.clearSearch()
+
+	case "enter":
+		m.searchActive = false
+		if m.searchQuery == "" {
+			m.clearSearch()
+		}
+
+	case "tab":
+		// Toggle between every visible column and the cursor column
+		if m.searchColumn != -1 {
+			m.searchColumn = -1
+		} else if cell, ok := m.cursorCell(); ok {
+			m.searchColumn = cell.Col
+		}
+
+	default:
+		m.searchQuery, _ = editText(m.searchQuery, msg)
+		if m.searchQuery == "" {
+			m.searchMatches, m.searchErr, m.searchFor = nil, nil, ""
+		}
+	}
+
+	return m, nil
+}
+
+// clearSearch stops the search and removes its highlights
+// A result still on its way is dropped, as it belongs to the old search
+func (m *AppModel) clearSearch() {
+	if m.searchCancel != nil {
+		m.searchCancel()
+		m.searchCancel = nil
+	}
+	m.searchID++
+	m.searchActive = false
+	m.searchQuery = ""
+	m.searchColumn = -1
+	m.searchMatches = nil
+	m.searchIndex = -1
+	m.searchErr = nil
+	m.searchRunning = false
+	m.searchFor = ""
+}
+
+// searchKey identifies everything a search result depends on
+func (m AppModel) searchKey() string {
+	return fmt.Sprintf("%p\x00%s\x00%d\x00%s\x00%s\x00%v",
+		m.dataTable, m.searchQuery, m.searchColumn, m.viewFilter, sortLabel(m.viewSort), m.hiddenColumns)
+}
+
+// refreshSearch starts a background search when the query, the table or the view changed
+// A running search for an older state is cancelled and its result dropped
+func (m *AppModel) refreshSearch() tea.Cmd {
+	if m.searchQuery == "" || m.dataTable == nil || m.searchKey() == m.searchFor {
+		return nil
+	}
+	if m.searchCancel != nil {
+		m.searchCancel()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.searchCancel = cancel
+	m.searchID++
+	m.searchFor = m.searchKey()
+	m.searchRunning = true
+
+	id, dt, rows := m.searchID, m.dataTable, m.viewRows
+	cols := m.visibleColumns()
+	opts := cleaner.SearchOptions{Query: m.searchQuery}
+	if len(m.searchQuery) > 2 && strings.HasPrefix(m.searchQuery, "/") && strings.HasSuffix(m.searchQuery, "/") {
+		opts.Query, opts.Regex = m.searchQuery[1:len(m.searchQuery)-1], true
+	}
+	opts.Columns = cols
+	if m.searchColumn != -1 {
+		opts.Columns = []int{m.searchColumn}
+	}
+
+	return func() tea.Msg {
+		cells, err := cleaner.FindCells(ctx, dt, opts, rows)
+		if err != nil {
+			return searchResultMsg{id: id, err: err}
+		}
+
+		// Positions in the table view, so n/N can move relative to the cursor
+		rowPos := make(map[int]int, len(rows))
+		for pos, row := range rows {
+			rowPos[row] = pos
+		}
+		colPos := make(map[int]int, len(cols))
+		for pos, col := range cols {
+			colPos[col] = pos
+		}
+
+		matches := make([]searchMatch, len(cells))
+		for i, cell := range cells {
+			pos := cell.Row
+			if rows != nil {
+				pos = rowPos[cell.Row]
+			}
+			matches[i] = searchMatch{row: cell.Row, col: cell.Col, pos: pos, cpos: colPos[cell.Col]}
+		}
+		return searchResultMsg{id: id, matches: matches}
+	}
+}
+
+// applySearchResult stores the result of the latest search
+// While the query is typed, the cursor follows the first match after it
+func (m AppModel) applySearchResult(msg searchResultMsg) AppModel {
+	if msg.id != m.searchID || m.searchQuery == "" || errors.Is(msg.err, context.Canceled) {
+		return m
+	}
+
+	m.searchRunning = false
+	m.searchCancel = nil
+	m.searchErr = msg.err
+	m.searchMatches = msg.matches
+	m.searchIndex = -1
+	if m.searchActive {
+		m.jumpToMatch(0)
+	}
+	return m
+}
+
+// jumpToMatch moves the cursor to the next (step 1) or previous (step -1) match
+// Step 0 selects the first match at or after the cursor
+func (m *AppModel) jumpToMatch(step int) {
+	n := len(m.searchMatches)
+	if n == 0 {
+		return
+	}
+
+	// First match at (or, moving forward, after) the cursor
+	i := sort.Search(n, func(i int) bool {
+		match := m.searchMatches[i]
+		if match.pos != m.cursorRow {
+			return match.pos > m.cursorRow
+		}
+		if step > 0 {
+			return match.cpos > m.cursorCol
+		}
+		return match.cpos >= m.cursorCol
+	})
+	if step < 0 {
+		i--
+	}
+	i = (i + n) % n
+
+	match := m.searchMatches[i]
+	m.searchIndex = i
+	m.moveCursor(match.pos-m.cursorRow, match.cpos-m.cursorCol)
+}
+
+// searchHighlights returns the matching cells on the visible page
+// Matches are in view order, so only the page's slice of them is visited
+func (m AppModel) searchHighlights() map[models.CellRef]bool {
+	first := sort.Search(len(m.searchMatches), func(i int) bool {
+		return m.searchMatches[i].pos >= m.scrollOffset
+	})
+
+	var cells map[models.CellRef]bool
+	for _, match := range m.searchMatches[first:] {
+		if match.pos >= m.scrollOffset+m.pageSize {
+			break
+		}
+		if cells == nil {
+			cells = make(map[models.CellRef]bool)
+		}
+		cells[models.CellRef{Row: match.row, Col: match.col}] = true
+	}
+	return cells
+}
+
+// searchLabel describes the search for the table info line
+func (m AppModel) searchLabel() string {
+	if m.searchQuery == "" && !m.searchActive {
+		return ""
+	}
+
+	label := m.searchQuery
+	if m.searchActive {
+		label = "/" + label + "▏"
+	}
+	if m.searchColumn != -1 && m.searchColumn < len(m.dataTable.Headers) {
+		label += " in " + m.dataTable.Headers[m.searchColumn]
+	}
+
+	switch {
+	case m.searchErr != nil:
+		label += fmt.Sprintf("  ✗ %v", m.searchErr)
+	case m.searchRunning:
+		label += "  ⏳ searching..."
+	case m.searchQuery != "" && m.searchIndex >= 0:
+		label += fmt.Sprintf("  (%d of %d matches)", m.searchIndex+1, len(m.searchMatches))
+	case m.searchQuery != "":
+		label += fmt.Sprintf("  (%d matches)", len(m.searchMatches))
+	}
+
+	if m.searchActive {
+		label += "  Tab: All/Cursor Column  |  Enter: Done  |  Esc: Clear"
+	}
+	return label
+}
+
+// openGoToRow asks for a row number and moves the cursor to it
+func (m *AppModel) openGoToRow() {
+	label := fmt.Sprintf("Go to row (1-%d):", m.dataTable.RowCount())
+	m.openPrompt("GO TO ROW", label, "", func(m AppModel, value string) AppModel {
+		row, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || row < 1 || row > m.dataTable.RowCount() {
+			m.tableMessage = fmt.Sprintf("✗ Invalid row %q", value)
+			return m
+		}
+
+		pos := m.rowPosition(row - 1)
+		if pos < 0 {
+			m.tableMessage = fmt.Sprintf("⚠ Row %d is hidden by the filter", row)
+			return m
+		}
+		m.moveCursor(pos-m.cursorRow, 0)
+		m.tableMessage = ""
+		return m
+	})
+}
+
+// rowPosition returns the position of a data row in the table view, -1 if filtered out
+func (m AppModel) rowPosition(row int) int {
+	if m.viewRows == nil {
+		return row
+	}
+	for pos, r := range m.viewRows {
+		if r == row {
+			return pos
+		}
+	}
+	return -1
+}
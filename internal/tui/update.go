@@ -2,11 +2,15 @@ package tui
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/veliulugut/snapclean/internal/cleaner"
 	"github.com/veliulugut/snapclean/internal/file"
 	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/recipe"
+	"github.com/veliulugut/snapclean/internal/tui/components"
 	"github.com/veliulugut/snapclean/internal/utils"
 )
 
@@ -17,6 +21,7 @@ type fileSelectedMsg struct {
 type fileLoadedMsg struct {
 	success   bool
 	message   string
+	path      string
 	dataTable *models.DataTable
 }
 
@@ -26,6 +31,8 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.pageSize = components.TablePageSize(msg.Width, msg.Height)
+		m.clampCursor()
 		return m, nil
 
 	case tickMsg:
@@ -46,7 +53,17 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.splashDone = true
 			return m, nil
 		}
-		return m.handleKeyPress(msg)
+		model, cmd := m.handleKeyPress(msg)
+		if am, ok := model.(AppModel); ok {
+			am.trackHistory(m)
+			am.syncViewFilter()
+			am.clampCursor()
+			if search := am.refreshSearch(); search != nil {
+				return am, tea.Batch(cmd, search)
+			}
+			return am, cmd
+		}
+		return model, cmd
 
 	case fileSelectedMsg:
 		if msg.path == "" {
@@ -54,7 +71,6 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
-		m.loadedFile = msg.path
 		m.statusText = "⏳ Loading file..."
 
 		return m, func() tea.Msg {
@@ -63,6 +79,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return fileLoadedMsg{
 					success:   false,
 					message:   fmt.Sprintf("✗ Failed to load: %v", err),
+					path:      msg.path,
 					dataTable: nil,
 				}
 			}
@@ -71,6 +88,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				success: true,
 				message: fmt.Sprintf("✓ Loaded: %s (%d rows, %d columns)",
 					table.FileName, table.RowCount(), table.ColumnCount()),
+				path:      msg.path,
 				dataTable: table,
 			}
 		}
@@ -78,11 +96,32 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case fileLoadedMsg:
 		m.statusText = msg.message
 		if msg.success {
-			m.dataTable = msg.dataTable
-			m.scrollOffset = 0
-			m.columnOffset = 0
+			m.openDataset(msg.dataTable, msg.path)
+			if len(m.datasets) > 1 {
+				m.statusText += fmt.Sprintf(" in tab %d of %d (Tab switches datasets)", m.activeDataset+1, len(m.datasets))
+			}
 		}
 		return m, nil
+
+	case joinFileSelectedMsg:
+		return m.loadJoinFile(msg.path)
+
+	case joinFileLoadedMsg:
+		return m.promptJoin(msg), nil
+
+	case appendLoadedMsg:
+		// The table is replaced outside a key press, so keep undo and the view in step here
+		am := m.applyAppend(msg)
+		am.trackHistory(m)
+		am.syncViewFilter()
+		am.clampCursor()
+		return am, am.refreshSearch()
+
+	case searchResultMsg:
+		return m.applySearchResult(msg), nil
+
+	case clustersMsg:
+		return m.applyClusters(msg), nil
 	}
 
 	return m, nil
@@ -90,11 +129,59 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // handleKeyPress routes key presses to appropriate handlers
 func (m AppModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Prompt takes all input while open
+	if m.promptActive {
+		return m.handlePromptInput(msg)
+	}
+
+	// So do the inline cell editor and the search input
+	if m.cellEditing {
+		return m.handleCellEditInput(msg)
+	}
+	if m.searchActive {
+		return m.handleSearchInput(msg)
+	}
+
+	// Row inspector over the table view
+	if m.currentView == tableView && m.inspecting {
+		return m.handleInspectorNavigation(msg)
+	}
+
 	// Cleaning view
 	if m.currentView == cleaningView {
 		return m.handleCleaningNavigation(msg)
 	}
 
+	// Fill view
+	if m.currentView == fillView {
+		return m.handleFillNavigation(msg)
+	}
+
+	// Recipe view
+	if m.currentView == recipeView {
+		return m.handleRecipeNavigation(msg)
+	}
+
+	// Column profile view
+	if m.currentView == profileView {
+		return m.handleProfileNavigation(msg)
+	}
+
+	// QA check view
+	if m.currentView == checkView {
+		return m.handleCheckNavigation(msg)
+	}
+
+	// Value mapping view
+	if m.currentView == mappingView {
+		return m.handleMappingNavigation(msg)
+	}
+
+	// Find/replace view
+	if m.currentView == replaceView {
+		return m.handleReplaceNavigation(msg)
+	}
+
 	// Table view with column menu
 	if m.currentView == tableView && m.columnMenuMode {
 		return m.handleColumnMenuNavigation(msg)
@@ -132,6 +219,10 @@ func (m AppModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.selectedItem < len(m.options)-1 {
 			m.selectedItem++
 		}
+	case "tab":
+		m.cycleDataset(1)
+	case "shift+tab":
+		m.cycleDataset(-1)
 	case "enter", " ":
 		return m.executeSelection()
 	}
@@ -144,13 +235,12 @@ func (m AppModel) handleTableNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
-	maxRowScroll := m.dataTable.RowCount() - m.pageSize
-	if maxRowScroll < 0 {
-		maxRowScroll = 0
-	}
-
 	switch msg.String() {
 	case "b", "esc":
+		if msg.String() == "esc" && m.searchQuery != "" {
+			m.clearSearch()
+			return m, nil
+		}
 		m.currentView = menuView
 		m.scrollOffset = 0
 		m.columnOffset = 0
@@ -159,50 +249,112 @@ func (m AppModel) handleTableNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "q", "ctrl+c":
 		return m, tea.Quit
 
-	// Vertical scroll
+	// Cell cursor (scrolls the table to keep the cursor visible)
 	case "up", "k":
-		if m.scrollOffset > 0 {
-			m.scrollOffset--
-		}
+		m.moveCursor(-1, 0)
 
 	case "down", "j":
-		if m.scrollOffset < maxRowScroll {
-			m.scrollOffset++
-		}
+		m.moveCursor(1, 0)
 
 	case "pgup":
-		m.scrollOffset -= m.pageSize
-		if m.scrollOffset < 0 {
-			m.scrollOffset = 0
-		}
+		m.moveCursor(-m.pageSize, 0)
 
 	case "pgdown":
-		m.scrollOffset += m.pageSize
-		if m.scrollOffset > maxRowScroll {
-			m.scrollOffset = maxRowScroll
-		}
+		m.moveCursor(m.pageSize, 0)
 
 	case "home":
-		m.scrollOffset = 0
+		m.moveCursor(-m.cursorRow, 0)
 
 	case "end":
-		m.scrollOffset = maxRowScroll
+		m.moveCursor(m.visibleRowCount(), 0)
 
-	// Horizontal scroll (for wide tables)
 	case "left", "h":
-		if m.columnOffset > 0 {
-			m.columnOffset--
-		}
+		m.moveCursor(0, -1)
 
 	case "right", "l":
-		maxColScroll := m.dataTable.ColumnCount() - 1
-		if maxColScroll < 0 {
-			maxColScroll = 0
+		m.moveCursor(0, 1)
+
+	// Row inspector
+	case "i":
+		m.openInspector()
+
+	// Layout
+	case "p":
+		m.toggleFrozenColumns()
+
+	case "-":
+		m.resizeColumn(-2)
+
+	case "+", "=":
+		m.resizeColumn(2)
+
+	// Manual edits
+	case "enter", "e":
+		m.openCellEditor()
+
+	case "o":
+		m.insertRow(true)
+
+	case "O":
+		m.insertRow(false)
+
+	case "D":
+		m.deleteRow()
+
+	// Row filtering
+	case "f":
+		m.openViewFilter()
+
+	case "F":
+		m.applyViewFilter()
+
+	// Row sorting
+	case "s":
+		m.openViewSort()
+
+	case "S":
+		m.applyViewSort()
+
+	// Undo the last operation
+	case "U", "ctrl+z":
+		m.statusText = m.undo()
+		m.tableMessage = m.statusText
+
+	// Search, then match or issue navigation
+	case "/":
+		m.openSearch()
+
+	case "g", ":":
+		m.openGoToRow()
+
+	case "n":
+		if m.searchQuery != "" {
+			m.jumpToMatch(1)
+		} else {
+			m.jumpToIssue(1)
 		}
-		if m.columnOffset < maxColScroll {
-			m.columnOffset++
+
+	case "N":
+		if m.searchQuery != "" {
+			m.jumpToMatch(-1)
+		} else {
+			m.jumpToIssue(-1)
 		}
 
+	// Find/replace across all columns
+	case "r":
+		m.openReplace("")
+
+	// Open datasets
+	case "tab":
+		m.cycleDataset(1)
+
+	case "shift+tab":
+		m.cycleDataset(-1)
+
+	case "ctrl+w":
+		m.openCloseDataset()
+
 	// Column menu toggle
 	case "c":
 		m.columnMenuMode = !m.columnMenuMode
@@ -255,34 +407,414 @@ func (m AppModel) handleColumnMenuNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd
 				m.columnMessage = "⚠ Cannot swap a column with itself."
 			} else {
 				m.swapColumns(m.swapSourceCol, m.selectedColumn)
-				m.columnMessage = fmt.Sprintf("✓ Swapped columns %d ↔ %d", m.swapSourceCol+1, m.selectedColumn+1)
 				m.swapSourceCol = -1
 			}
 		}
+
+	case "u":
+		header := m.dataTable.Headers[m.selectedColumn]
+		current := ""
+		if m.dataTable.Nulls != nil {
+			current = strings.Join(m.dataTable.Nulls.PerColumn[header], ", ")
+		}
+		m.openPrompt("NULL MARKERS", fmt.Sprintf("Extra null markers for %q (comma separated):", header), current,
+			func(m AppModel, value string) AppModel {
+				params := recipe.NullMarkersParams{Column: header, Markers: splitHeaders(value)}
+				result, err := cleaner.SetNullMarkers(m.dataTable, params.Column, params.Markers)
+				if err != nil {
+					m.columnMessage = fmt.Sprintf("✗ %v", err)
+					return m
+				}
+
+				m.dataTable = result
+				m.recordStep(recipe.OpSetNullMarkers, params)
+				m.columnMessage = fmt.Sprintf("✓ %d null markers set for %s (U to undo)", len(params.Markers), header)
+				return m
+			})
+
+	case "d":
+		header := m.dataTable.Headers[m.selectedColumn]
+		m.openPrompt("NORMALIZE DATES", fmt.Sprintf("Output format for %q (YYYY, MM, DD, HH, mm, ss):", header), cleaner.DateFormatISO,
+			func(m AppModel, format string) AppModel {
+				params := recipe.DateParams{
+					Column:      header,
+					DateOptions: cleaner.DateOptions{OutputFormat: format, DayFirst: true},
+				}
+				// Plain numbers are read as Excel serials only when the values that are not dates all look like them
+				params.ExcelSerial = cleaner.IsExcelSerialColumn(m.dataTable, header)
+				result, err := cleaner.NormalizeDates(m.dataTable, params.Column, params.DateOptions)
+				if err != nil {
+					m.columnMessage = fmt.Sprintf("✗ %v", err)
+					return m
+				}
+
+				m.dataTable = result.Table
+				m.recordStep(recipe.OpNormalizeDates, params)
+				m.flagged = result.Unparseable
+				m.columnMessage = fmt.Sprintf("✓ %s: %d dates converted (formats: %s)",
+					header, result.Converted, strings.Join(result.Formats, ", "))
+				if len(result.Unparseable) > 0 {
+					m.columnMessage += fmt.Sprintf("  ⚠ %d unparseable cells highlighted (first at row %d)",
+						len(result.Unparseable), result.Unparseable[0].Row+1)
+				}
+				return m
+			})
+
+	case "n":
+		header := m.dataTable.Headers[m.selectedColumn]
+		m.openPrompt("NORMALIZE NUMBERS", fmt.Sprintf("Column for currency/unit symbols of %q (empty to drop them):", header), header+"_unit",
+			func(m AppModel, unitColumn string) AppModel {
+				params := recipe.NumberParams{
+					Column:        header,
+					NumberOptions: cleaner.NumberOptions{UnitColumn: strings.TrimSpace(unitColumn)},
+				}
+				result, err := cleaner.NormalizeNumbers(m.dataTable, params.Column, params.NumberOptions)
+				if err != nil {
+					m.columnMessage = fmt.Sprintf("✗ %v", err)
+					return m
+				}
+
+				m.dataTable = result.Table
+				m.recordStep(recipe.OpNormalizeNumbers, params)
+				m.flagged = result.Unparseable
+				m.columnMessage = fmt.Sprintf("✓ %s: %d numbers converted (decimal %q, thousands %q)",
+					header, result.Converted, result.DecimalSeparator, result.ThousandsSeparator)
+				if len(result.Unparseable) > 0 {
+					m.columnMessage += fmt.Sprintf("  ⚠ %d unparseable cells highlighted (first at row %d)",
+						len(result.Unparseable), result.Unparseable[0].Row+1)
+				}
+				return m
+			})
+
+	case "r":
+		m.columnMenuMode = false
+		m.openReplace(m.dataTable.Headers[m.selectedColumn])
+
+	case "s":
+		header := m.dataTable.Headers[m.selectedColumn]
+		m.openPrompt("SPLIT COLUMN", fmt.Sprintf("Split %q by delimiter, /regex with groups/ or @positions (e.g. @3,7):", header), " ",
+			func(m AppModel, spec string) AppModel {
+				opts := parseSplitSpec(header, spec)
+				m.openPrompt("SPLIT COLUMN", "New column names (comma separated, empty for defaults):", "",
+					func(m AppModel, names string) AppModel {
+						opts.NewColumns = splitHeaders(names)
+						result, err := cleaner.SplitColumn(m.dataTable, opts)
+						if err != nil {
+							m.columnMessage = fmt.Sprintf("✗ %v", err)
+							return m
+						}
+						added := result.ColumnCount() - m.dataTable.ColumnCount() + 1
+						m.dataTable = result
+						m.clearCellMarks()
+						m.recordStep(recipe.OpSplitColumn, opts)
+						m.columnMessage = fmt.Sprintf("✓ Split %s into %d columns", header, added)
+						return m
+					})
+				return m
+			})
+
+	case "m":
+		header := m.dataTable.Headers[m.selectedColumn]
+		m.openPrompt("MERGE COLUMNS", "Columns to merge, in order (comma separated):", header+", ",
+			func(m AppModel, columns string) AppModel {
+				opts := cleaner.MergeOptions{Columns: splitHeaders(columns), SkipMissing: true}
+				m.openPrompt("MERGE COLUMNS", "Separator:", " ", func(m AppModel, sep string) AppModel {
+					opts.Separator = sep
+					m.openPrompt("MERGE COLUMNS", "New column name:", strings.Join(opts.Columns, "_"),
+						func(m AppModel, name string) AppModel {
+							opts.NewColumn = strings.TrimSpace(name)
+							result, err := cleaner.MergeColumns(m.dataTable, opts)
+							if err != nil {
+								m.columnMessage = fmt.Sprintf("✗ %v", err)
+								return m
+							}
+							m.dataTable = result
+							m.selectedColumn = min(m.selectedColumn, result.ColumnCount()-1)
+							m.clearCellMarks()
+							m.recordStep(recipe.OpMergeColumns, opts)
+							m.columnMessage = fmt.Sprintf("✓ Merged %d columns into %s", len(opts.Columns), opts.NewColumn)
+							return m
+						})
+					return m
+				})
+				return m
+			})
+
+	case "e":
+		header := m.dataTable.Headers[m.selectedColumn]
+		m.openPrompt("COMPUTED COLUMN", "Column to create or overwrite:", header,
+			func(m AppModel, column string) AppModel {
+				opts := cleaner.ComputeOptions{Column: strings.TrimSpace(column)}
+				m.openPrompt("COMPUTED COLUMN", "Expression (e.g. price * qty, upper(city), if(status == \"X\", \"closed\", status)):", "",
+					func(m AppModel, expression string) AppModel {
+						opts.Expression = expression
+						result, err := cleaner.ComputeColumn(m.dataTable, opts)
+						if err != nil {
+							m.columnMessage = fmt.Sprintf("✗ %v", err)
+							return m
+						}
+
+						m.dataTable = result.Table
+						m.clearCellMarks()
+						colIdx := result.Table.ColumnIndex(opts.Column)
+						for _, rowErr := range result.Errors {
+							m.flagged = append(m.flagged, models.CellRef{Row: rowErr.Row, Col: colIdx})
+						}
+						m.recordStep(recipe.OpComputeColumn, opts)
+
+						m.columnMessage = fmt.Sprintf("✓ Computed %s", opts.Column)
+						if len(result.Errors) > 0 {
+							first := result.Errors[0]
+							m.columnMessage += fmt.Sprintf("  ⚠ %d rows failed, highlighted (row %d: %s)",
+								len(result.Errors), first.Row+1, first.Message)
+						}
+						return m
+					})
+				return m
+			})
+
+	case "v":
+		m.openMapping(m.dataTable.Headers[m.selectedColumn])
+
+	case "p":
+		m.openProfile(m.selectedColumn)
+
+	case "x":
+		m.openSplitExport(m.dataTable.Headers[m.selectedColumn])
+
+	// Column management
+	case " ":
+		if m.columnMarks == nil {
+			m.columnMarks = make(map[int]bool)
+		}
+		if m.columnMarks[m.selectedColumn] {
+			delete(m.columnMarks, m.selectedColumn)
+		} else {
+			m.columnMarks[m.selectedColumn] = true
+		}
+		if m.selectedColumn < maxCol {
+			m.selectedColumn++
+		}
+
+	case "D":
+		m.deleteColumns()
+
+	case "O":
+		m.keepColumns()
+
+	case "R":
+		m.openRenameColumn()
+
+	case "C":
+		m.openDuplicateColumn()
+
+	case "K":
+		m.moveColumn(m.selectedColumn - 1)
+
+	case "J":
+		m.moveColumn(m.selectedColumn + 1)
+
+	case "M":
+		m.openMoveColumn()
+
+	case "H":
+		m.toggleHiddenColumns()
+
+	case "U", "ctrl+z":
+		m.columnMessage = m.undo()
+
+	case "z":
+		m.toggleSortColumn(m.selectedColumn)
+		if len(m.viewSort) == 0 {
+			m.columnMessage = "✓ View sort cleared"
+		} else {
+			m.columnMessage = fmt.Sprintf("✓ View sorted by %s (S in the table applies it)", sortLabel(m.viewSort))
+		}
+
+	case "a":
+		header := m.dataTable.Headers[m.selectedColumn]
+		label := fmt.Sprintf("Rule for %s (required, unique, type integer, min 0, max 100, length 2 50, pattern ^\\d+$, allowed a,b,c, date_range 2020-01-01 *, compare >= other):", header)
+		m.openPrompt("ADD RULE", label, "", func(m AppModel, spec string) AppModel {
+			rule, err := parseRuleSpec(header, spec)
+			if err == nil {
+				_, err = cleaner.ValidateRules(m.dataTable, []cleaner.Rule{rule})
+			}
+			if err != nil {
+				m.columnMessage = fmt.Sprintf("✗ %v", err)
+				return m
+			}
+			m.rules.Rules = append(m.rules.Rules, rule)
+			m.columnMessage = fmt.Sprintf("✓ Rule %q added (%d rules). Run CHECK to see violations.", rule.DisplayName(), len(m.rules.Rules))
+			return m
+		})
+
+	case "o":
+		header := m.dataTable.Headers[m.selectedColumn]
+		m.openPrompt("OUTLIERS", "Method (iqr 1.5, zscore 3, modified_zscore 3.5, bounds 0 100; * for an open bound):", "iqr 1.5",
+			func(m AppModel, spec string) AppModel {
+				opts, err := parseOutlierSpec(header, spec)
+				if err != nil {
+					m.columnMessage = fmt.Sprintf("✗ %v", err)
+					return m
+				}
+				report, err := cleaner.DetectOutliers(m.dataTable, opts)
+				if err != nil {
+					m.columnMessage = fmt.Sprintf("✗ %v", err)
+					return m
+				}
+
+				// Highlight first, the chosen action may then change the cells
+				m.clearCellMarks()
+				colIdx := m.dataTable.ColumnIndex(header)
+				for _, o := range report.Outliers {
+					m.flagged = append(m.flagged, models.CellRef{Row: o.Row, Col: colIdx})
+				}
+				if len(report.Outliers) == 0 {
+					m.columnMessage = fmt.Sprintf("✓ No outliers in %s", header)
+					return m
+				}
+
+				label := fmt.Sprintf("%d outliers highlighted. Action (cap, null, remove; empty to keep):", len(report.Outliers))
+				m.openPrompt("OUTLIERS", label, "", func(m AppModel, action string) AppModel {
+					action = strings.ToLower(strings.TrimSpace(action))
+					if action == "" {
+						m.columnMessage = fmt.Sprintf("⚠ %d outliers in %s highlighted", len(report.Outliers), header)
+						return m
+					}
+					params := recipe.OutlierParams{OutlierOptions: opts, Action: cleaner.OutlierAction(action)}
+					result, report, err := cleaner.HandleOutliers(m.dataTable, opts, params.Action)
+					if err != nil {
+						m.columnMessage = fmt.Sprintf("✗ %v", err)
+						return m
+					}
+					m.dataTable = result
+					m.clearCellMarks()
+					m.recordStep(recipe.OpHandleOutliers, params)
+					m.columnMessage = fmt.Sprintf("✓ %s: %d outliers handled (%s)", header, len(report.Outliers), action)
+					return m
+				})
+				return m
+			})
+
+	case "t":
+		header := m.dataTable.Headers[m.selectedColumn]
+		m.openPrompt("CHANGE CASE", fmt.Sprintf("Case for %s (upper, lower, title; add \"tr\" for Turkish rules):", header), "title tr",
+			func(m AppModel, value string) AppModel {
+				opts := cleaner.TextOptions{Columns: []string{header}}
+				for _, word := range strings.Fields(strings.ToLower(value)) {
+					if word == "tr" {
+						opts.Turkish = true
+					} else {
+						opts.Case = cleaner.TextCase(word)
+					}
+				}
+				if opts.Case == cleaner.CaseNone {
+					m.columnMessage = "⚠ No case given (upper, lower or title)"
+					return m
+				}
+				result, changed, err := cleaner.StandardizeText(m.dataTable, opts)
+				if err != nil {
+					m.columnMessage = fmt.Sprintf("✗ %v", err)
+					return m
+				}
+				m.dataTable = result
+				m.recordStep(recipe.OpStandardizeText, opts)
+				m.columnMessage = fmt.Sprintf("✓ %s: %d cells changed to %s case", header, changed, opts.Case)
+				return m
+			})
+
+	case "f":
+		m.currentView = fillView
+		m.fillColumn = m.selectedColumn
+		m.fillStrategy = 0
+		m.fillGroupBy = -1
+		m.fillValue = ""
+		m.fillEditing = false
+		m.fillMessage = ""
 	}
 
 	return m, nil
 }
 
-// swapColumns swaps two columns in the data table
-func (m *AppModel) swapColumns(col1, col2 int) {
-	if m.dataTable == nil || col1 < 0 || col2 < 0 {
-		return
-	}
-	if col1 >= m.dataTable.ColumnCount() || col2 >= m.dataTable.ColumnCount() {
-		return
+// handleFillNavigation handles navigation in the fill missing values view
+func (m AppModel) handleFillNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.fillEditing {
+		switch msg.String() {
+		case "enter", "esc":
+			m.fillEditing = false
+		default:
+			m.fillValue, _ = editText(m.fillValue, msg)
+		}
+		return m, nil
 	}
 
-	// Swap headers
-	m.dataTable.Headers[col1], m.dataTable.Headers[col2] = m.dataTable.Headers[col2], m.dataTable.Headers[col1]
+	switch msg.String() {
+	case "b", "esc":
+		m.currentView = tableView
+		m.fillMessage = ""
+		return m, nil
+
+	case "q", "ctrl+c":
+		return m, tea.Quit
+
+	case "up", "k":
+		if m.fillStrategy > 0 {
+			m.fillStrategy--
+		}
+
+	case "down", "j":
+		if m.fillStrategy < len(cleaner.FillStrategies)-1 {
+			m.fillStrategy++
+		}
 
-	// Swap all row data
-	for i := 0; i < m.dataTable.RowCount(); i++ {
-		row, err := m.dataTable.GetRow(i)
-		if err == nil && col1 < len(row) && col2 < len(row) {
-			m.dataTable.Rows[i][col1], m.dataTable.Rows[i][col2] = m.dataTable.Rows[i][col2], m.dataTable.Rows[i][col1]
+	case "g":
+		// Cycle through columns, skipping the one being filled, then back to none
+		next := m.fillGroupBy + 1
+		if next == m.fillColumn {
+			next++
 		}
+		if next >= m.dataTable.ColumnCount() {
+			next = -1
+		}
+		m.fillGroupBy = next
+
+	case "v":
+		m.fillEditing = true
+		m.fillStrategy = 0 // constant
+
+	case "enter":
+		rule := cleaner.FillRule{
+			Column:   m.dataTable.Headers[m.fillColumn],
+			Strategy: cleaner.FillStrategies[m.fillStrategy],
+			Value:    m.fillValue,
+		}
+		if m.fillGroupBy >= 0 {
+			rule.GroupBy = m.dataTable.Headers[m.fillGroupBy]
+		}
+
+		result, err := cleaner.FillMissing(m.dataTable, []cleaner.FillRule{rule})
+		if err != nil {
+			m.fillMessage = fmt.Sprintf("✗ %v", err)
+			return m, nil
+		}
+
+		m.dataTable = result.Table
+		m.imputed = append(m.imputed, result.Imputed...)
+		m.recordStep(recipe.OpFill, []cleaner.FillRule{rule})
+		m.fillMessage = fmt.Sprintf("✓ Filled %d cells in %s using %s", len(result.Imputed), rule.Column, rule.Strategy)
+		m.statusText = m.fillMessage
+
+	case "i":
+		if len(m.imputed) == 0 {
+			m.fillMessage = "⚠ No imputed cells to mark."
+			return m, nil
+		}
+		m.dataTable = cleaner.AddImputedIndicators(m.dataTable, m.imputed)
+		m.imputed = nil
+		m.fillMessage = "✓ Added imputed indicator columns"
 	}
+
+	return m, nil
 }
 
 // handleCleaningNavigation handles navigation in cleaning view
@@ -302,7 +834,7 @@ func (m AppModel) handleCleaningNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 		}
 
 	case "down", "j":
-		if m.cleaningSelected < 4 {
+		if m.cleaningSelected < 8 {
 			m.cleaningSelected++
 		}
 
@@ -319,13 +851,16 @@ func (m AppModel) handleCleaningNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 		beforeRows := m.dataTable.RowCount()
 		beforeCols := m.dataTable.ColumnCount()
 
-		// Apply cleaning
+		// Apply cleaning; the markers are recorded as they were before, keyed by the original headers
+		nulls := m.dataTable.Nulls.Clone()
 		cleaned := cleaner.ApplyCleaningOptions(m.dataTable, m.cleaningOptions)
 
 		afterRows := cleaned.RowCount()
 		afterCols := cleaned.ColumnCount()
 
 		m.dataTable = cleaned
+		m.clearCellMarks()
+		m.recordStep(recipe.OpClean, recipe.CleanParams{CleanOptions: m.cleaningOptions, Nulls: nulls})
 
 		// Show summary
 		m.cleaningMessage = fmt.Sprintf(
@@ -345,13 +880,21 @@ func (m *AppModel) toggleCleaningOption(idx int) {
 	case 0:
 		m.cleaningOptions.TrimWhitespace = !m.cleaningOptions.TrimWhitespace
 	case 1:
-		m.cleaningOptions.NormalizeHeaders = !m.cleaningOptions.NormalizeHeaders
+		m.cleaningOptions.CollapseSpaces = !m.cleaningOptions.CollapseSpaces
 	case 2:
-		m.cleaningOptions.RemoveEmptyRows = !m.cleaningOptions.RemoveEmptyRows
+		m.cleaningOptions.RemoveInvisible = !m.cleaningOptions.RemoveInvisible
 	case 3:
-		m.cleaningOptions.RemoveEmptyColumns = !m.cleaningOptions.RemoveEmptyColumns
+		m.cleaningOptions.NormalizeUnicode = !m.cleaningOptions.NormalizeUnicode
 	case 4:
+		m.cleaningOptions.NormalizeHeaders = !m.cleaningOptions.NormalizeHeaders
+	case 5:
+		m.cleaningOptions.RemoveEmptyRows = !m.cleaningOptions.RemoveEmptyRows
+	case 6:
+		m.cleaningOptions.RemoveEmptyColumns = !m.cleaningOptions.RemoveEmptyColumns
+	case 7:
 		m.cleaningOptions.RemoveDuplicates = !m.cleaningOptions.RemoveDuplicates
+	case 8:
+		m.cleaningOptions.StandardizeNulls = !m.cleaningOptions.StandardizeNulls
 	}
 }
 
@@ -387,11 +930,31 @@ func (m AppModel) executeSelection() (tea.Model, tea.Cmd) {
 		m.cleaningMessage = ""
 		return m, nil
 
-	case 6: // Help
+	case 4: // QA Checks
+		if m.dataTable == nil {
+			m.statusText = "⚠ No data loaded. Please load a file first."
+			return m, nil
+		}
+		m.runChecks()
+		m.currentView = checkView
+		return m, nil
+
+	case 5: // Recipe
+		m.currentView = recipeView
+		m.recipeMessage = ""
+		return m, nil
+
+	case 6: // Join another file
+		return m.openJoin()
+
+	case 7: // Append files
+		return m.openAppend()
+
+	case 9: // Help
 		m.currentView = helpView
 		return m, nil
 
-	case 7: // Exit
+	case 10: // Exit
 		return m, tea.Quit
 
 	default:
@@ -399,3 +962,40 @@ func (m AppModel) executeSelection() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 }
+
+// clearCellMarks drops highlighted cells whose positions are no longer valid
+func (m *AppModel) clearCellMarks() {
+	m.imputed = nil
+	m.flagged = nil
+	m.issues = nil
+	m.issueIndex = -1
+	m.tableMessage = ""
+	m.edited = nil
+}
+
+// parseSplitSpec turns the split prompt input into split options:
+// "/regex/" splits by capture groups, "@3,7" cuts at positions, anything else is a delimiter
+func parseSplitSpec(column, spec string) cleaner.SplitOptions {
+	opts := cleaner.SplitOptions{Column: column, Mode: cleaner.SplitByDelimiter, Delimiter: spec}
+
+	trimmed := strings.TrimSpace(spec)
+	switch {
+	case len(trimmed) > 2 && strings.HasPrefix(trimmed, "/") && strings.HasSuffix(trimmed, "/"):
+		opts.Mode = cleaner.SplitByRegex
+		opts.Pattern = trimmed[1 : len(trimmed)-1]
+		opts.Delimiter = ""
+
+	case strings.HasPrefix(trimmed, "@"):
+		opts.Mode = cleaner.SplitByPositions
+		opts.Delimiter = ""
+		for _, part := range splitHeaders(trimmed[1:]) {
+			pos, err := strconv.Atoi(part)
+			if err != nil {
+				pos = -1 // rejected by SplitColumn
+			}
+			opts.Positions = append(opts.Positions, pos)
+		}
+	}
+
+	return opts
+}
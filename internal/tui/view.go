@@ -1,7 +1,11 @@
 // filepath: internal/tui/view.go
 package tui
 
-import "github.com/veliulugut/snapclean/internal/tui/components"
+import (
+	"github.com/veliulugut/snapclean/internal/cleaner"
+	"github.com/veliulugut/snapclean/internal/models"
+	"github.com/veliulugut/snapclean/internal/tui/components"
+)
 
 // var (
 // 	titleStyle = lipgloss.NewStyle().
@@ -45,25 +49,103 @@ func (m AppModel) View() string {
 		return components.RenderSplash(m.splashTick)
 	}
 
+	if m.promptActive {
+		return components.RenderPrompt(components.PromptViewModel{
+			Title: m.promptTitle,
+			Label: m.promptLabel,
+			Value: m.promptValue,
+		})
+	}
+
 	if m.currentView == helpView {
 		return components.RenderHelp()
 	}
 
 	if m.currentView == tableView {
 		if m.columnMenuMode {
-			return components.RenderColumnMenu(
-				m.dataTable,
-				m.selectedColumn,
-				m.columnMessage,
-			)
+			return components.RenderColumnMenu(components.ColumnMenuViewModel{
+				Data:       m.dataTable,
+				Selected:   m.selectedColumn,
+				Marked:     m.columnMarks,
+				Hidden:     m.hiddenColumns,
+				SwapSource: m.swapSourceCol,
+				Message:    m.columnMessage,
+			})
+		}
+		if m.inspecting {
+			return components.RenderInspector(m.inspectorViewModel())
+		}
+		return components.RenderTable(m.tableViewModel())
+	}
+
+	// Recipe view - renders the recorded steps
+	if m.currentView == recipeView {
+		return components.RenderRecipe(components.RecipeViewModel{
+			Recipe:  m.recipe,
+			Message: m.recipeMessage,
+		})
+	}
+
+	// Column profile view - renders statistics for one column
+	if m.currentView == profileView {
+		return components.RenderProfile(components.ProfileViewModel{
+			Profile: m.profile,
+			Index:   m.profileColumn,
+			Total:   m.dataTable.ColumnCount(),
+		})
+	}
+
+	// QA check view - renders validation results
+	if m.currentView == checkView {
+		return components.RenderCheck(components.CheckViewModel{
+			Result:     m.checkResult,
+			Violations: m.checkViolations,
+			RuleCount:  len(m.rules.Rules),
+			Issues:     m.issues,
+			Page:       m.issuePage,
+			Message:    m.checkMessage,
+		})
+	}
+
+	// Value mapping view - renders distinct values and pending mappings
+	if m.currentView == mappingView {
+		return components.RenderMapping(components.MappingViewModel{
+			Column:   m.mappingColumn,
+			Values:   m.mappingValues,
+			Targets:  m.mappingTargets,
+			Marked:   m.mappingMarked,
+			Cursor:   m.mappingCursor,
+			PageSize: m.pageSize,
+			Message:  m.mappingMessage,
+		})
+	}
+
+	// Find/replace view - renders the form with a live preview
+	if m.currentView == replaceView {
+		return components.RenderReplace(components.ReplaceViewModel{
+			Options: m.replaceOptions,
+			Columns: m.replaceColumns,
+			Focus:   m.replaceFocus,
+			Preview: m.replacePreview,
+			Error:   m.replaceError,
+			Message: m.replaceMessage,
+		})
+	}
+
+	// Fill view - renders missing value fill options for one column
+	if m.currentView == fillView {
+		vm := components.FillViewModel{
+			Column:       m.dataTable.Headers[m.fillColumn],
+			MissingCount: cleaner.GetMissingValuesByColumn(m.dataTable)[m.dataTable.Headers[m.fillColumn]],
+			Strategy:     cleaner.FillStrategies[m.fillStrategy],
+			Value:        m.fillValue,
+			Editing:      m.fillEditing,
+			Message:      m.fillMessage,
 		}
-		return components.RenderTable(
-			m.dataTable,
-			m.scrollOffset,
-			m.columnOffset,
-			m.pageSize,
-			m.width,
-		)
+		if m.fillGroupBy >= 0 {
+			vm.GroupBy = m.dataTable.Headers[m.fillGroupBy]
+		}
+		return components.RenderFill(vm)
 	}
 
 	// Cleaning view - renders cleaning options menu
@@ -76,5 +158,66 @@ func (m AppModel) View() string {
 	}
 
 	// Default : Menu view
-	return components.RenderMenu(m.selectedItem, m.options, m.statusText)
+	return components.RenderMenu(m.selectedItem, m.options, m.statusText, m.datasetNames(), m.activeDataset)
+}
+
+// tableViewModel collects the state rendered by the table view
+func (m AppModel) tableViewModel() components.TableViewModel {
+	return components.TableViewModel{
+		Data:         m.dataTable,
+		ScrollOffset: m.scrollOffset,
+		ColumnOffset: m.columnOffset,
+		Columns:      m.visibleColumns(),
+		PageSize:     m.pageSize,
+		Width:        m.width,
+		Frozen:       m.frozenColumns,
+		FrozenStart:  m.frozenStart,
+		ColumnWidths: m.columnWidths,
+		Highlights:   m.cellHighlights(),
+		RowIndices:   m.viewRows,
+		FilterLabel:  m.viewFilter,
+		SortLabel:    sortLabel(m.viewSort),
+		SortMarkers:  m.sortMarkers(),
+		Matches:      m.searchHighlights(),
+		SearchLabel:  m.searchLabel(),
+		Focus:        m.issueFocus(),
+		Cursor:       m.tableCursor(),
+		Editing:      m.cellEditing,
+		EditValue:    m.cellValue,
+		Message:      m.tableMessage,
+		Tabs:         m.datasetNames(),
+		ActiveTab:    m.activeDataset,
+	}
+}
+
+// tableLayout returns the columns of the table view that fit the terminal
+func (m AppModel) tableLayout() components.TableLayout {
+	return components.LayoutTable(components.TableViewModel{
+		Data:         m.dataTable,
+		ColumnOffset: m.columnOffset,
+		Columns:      m.visibleColumns(),
+		Width:        m.width,
+		Frozen:       m.frozenColumns,
+		FrozenStart:  m.frozenStart,
+		ColumnWidths: m.columnWidths,
+		SortMarkers:  m.sortMarkers(),
+	})
+}
+
+// cellHighlights returns the cells to highlight in the table view
+func (m AppModel) cellHighlights() map[models.CellRef]bool {
+	if len(m.imputed) == 0 && len(m.flagged) == 0 && len(m.edited) == 0 {
+		return nil
+	}
+	highlights := make(map[models.CellRef]bool, len(m.imputed)+len(m.flagged)+len(m.edited))
+	for _, ref := range m.imputed {
+		highlights[ref] = true
+	}
+	for _, ref := range m.flagged {
+		highlights[ref] = true
+	}
+	for _, ref := range m.edited {
+		highlights[ref] = true
+	}
+	return highlights
 }